@@ -1,96 +1,284 @@
-package main
+package gogram
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
+	"mime/multipart"
 	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
 )
 
-// Creates new bot
-func NewBot(Token string) (*Bot, error) {
-	bot := Bot{Token, true}
-	_, err := bot.GetMe()
-	return &bot, err
+// Creates new bot. Unless WithoutStartupCheck is passed, the token is checked with getMe
+// and the returned user is stored in Bot.Self.
+func NewBot(Token string, options ...Option) (*Bot, error) {
+	bot := &Bot{Token: Token}
+	for _, option := range options {
+		option(bot)
+	}
+	if bot.skipStartupCheck {
+		return bot, nil
+	}
+	self, err := bot.GetMe(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	bot.Self = self
+	return bot, nil
 }
 
+// Address of the cloud Bot API server
+const DefaultServerURL = "https://api.telegram.org"
+
 type Bot struct {
 	Token string
+
+	// Log every request at info level instead of debug level
 	Debug bool
+
+	// HTTP client used for all requests. If nil, http.DefaultClient is used.
+	// Its Timeout must be longer than the long polling timeout of StartPolling (30 seconds)
+	Client *http.Client
+
+	// Base URL of the Bot API server. If empty, DefaultServerURL is used.
+	// Set it to the address of a local Bot API server to use one
+	ServerURL string
+
+	// Base URL used to download files. If empty, ServerURL + "/file" is used
+	FileURL string
+
+	// Logger the bot writes to. If nil, slog.Default() is used.
+	// Every request is logged at debug level, or at info level if Debug is set
+	Logger *slog.Logger
+
+	// The bot itself, as returned by getMe when the bot was created
+	Self *User
+
+	// Interceptors wrapping every request made by MakeRequest, the first one being the outermost.
+	// Each call the innermost interceptor makes to the Bot API is logged separately
+	Interceptors []Interceptor
+
+	skipStartupCheck bool
+
+	// Offset of the next update to request with getUpdates while polling
+	offset   int
+	offsetMu sync.Mutex
 }
 
-// This func makes requests
-func (bot *Bot) MakeRequest(Method string, data any) (result *Response) {
-	json_data, err := json.Marshal(data)
+// This func makes requests. It returns an error if the request could not be sent,
+// the response could not be decoded, or the Bot API answered with "ok": false,
+// in which case the error is an *APIError and the decoded response is returned as well.
+// The bot token never appears in the returned errors.
+// The request passes through Interceptors before it is sent.
+func (bot *Bot) MakeRequest(ctx context.Context, Method string, data any) (*Response, error) {
+	request := bot.sendRequest
+	for i := len(bot.Interceptors) - 1; i >= 0; i-- {
+		request = bot.Interceptors[i](request)
+	}
+	return request(ctx, Method, data)
+}
+
+// Sends the request, redacts the token from the error and logs the request
+func (bot *Bot) sendRequest(ctx context.Context, Method string, data any) (*Response, error) {
+	start := time.Now()
+	result, err := bot.makeRequest(ctx, Method, data)
 	if err != nil {
-		return
+		err = bot.redactError(err)
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("https://api.telegram.org/bot%s/%s", bot.Token, Method), bytes.NewBuffer(json_data))
+	bot.logRequest(ctx, Method, time.Since(start), err)
+	return result, err
+}
+
+func (bot *Bot) makeRequest(ctx context.Context, Method string, data any) (*Response, error) {
+	body, contentType, err := requestBody(data)
 	if err != nil {
-		return
+		return nil, fmt.Errorf("method %s: encoding parameters: %w", Method, err)
 	}
-	req.Header.Set("Content-Type", "application/json")
-	if bot.Debug {
-		log.Printf("Connecting to %s", fmt.Sprintf("https://api.telegram.org/bot%s/%s\n", bot.Token, Method))
+	req, err := http.NewRequestWithContext(ctx, "POST", bot.methodURL(Method), body)
+	if err != nil {
+		body.Close()
+		return nil, fmt.Errorf("method %s: %w", Method, err)
 	}
-	response, err := http.DefaultClient.Do(req)
+	req.Header.Set("Content-Type", contentType)
+	response, err := bot.client().Do(req)
 	if err != nil {
-		return
+		return nil, fmt.Errorf("method %s: %w", Method, err)
 	}
+	defer response.Body.Close()
+
 	responseBody, err := io.ReadAll(response.Body)
-	json.Unmarshal(responseBody, &result)
-	if result.Ok {
-		log.Println("Successfully")
-	} else {
-		log.Println("Not successfully")
+	if err != nil {
+		return nil, fmt.Errorf("method %s: reading response: %w", Method, err)
 	}
-	return
+	var result Response
+	if err := json.Unmarshal(responseBody, &result); err != nil {
+		// The Bot API answers errors with a JSON body too, so an undecodable body
+		// with a bad status usually comes from a proxy in front of it
+		if response.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("method %s: unexpected HTTP status: %s", Method, response.Status)
+		}
+		return nil, fmt.Errorf("method %s: decoding response: %w", Method, err)
+	}
+	return &result, result.error(Method)
 }
 
-// A simple method for testing your bot's authentication token. Requires no parameters. Returns basic information about the bot in form of a User object.
-func (bot *Bot) GetMe() (*User, error) {
-	response := bot.MakeRequest("getMe", nil)
-	if !response.Ok {
-		return nil, fmt.Errorf("function GetMe finished with error_code: %d, description: %s", response.ErrorCode, response.Description)
-	}
-	result, err := json.Marshal(response)
+// Returns the body of a request with the given parameters and its content type.
+// Parameters containing files to upload are streamed as multipart/form-data, others are sent as JSON.
+func requestBody(data any) (io.ReadCloser, string, error) {
+	files := collectUploads(data)
+	json_data, err := json.Marshal(data)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+	if len(files) == 0 {
+		return io.NopCloser(bytes.NewReader(json_data)), "application/json", nil
+	}
+
+	pipeReader, pipeWriter := io.Pipe()
+	writer := multipart.NewWriter(pipeWriter)
+	go func() {
+		pipeWriter.CloseWithError(writeMultipart(writer, json_data, files))
+	}()
+	return pipeReader, writer.FormDataContentType(), nil
+}
+
+// Logs a finished request at debug level, or at info level if Debug is set
+func (bot *Bot) logRequest(ctx context.Context, method string, duration time.Duration, err error) {
+	level := slog.LevelDebug
+	if bot.Debug {
+		level = slog.LevelInfo
+	}
+	logger := bot.logger()
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.Duration("duration", duration),
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		attrs = append(attrs, slog.Int("error_code", apiErr.ErrorCode))
+		if apiErr.Parameters.RetryAfter != 0 {
+			attrs = append(attrs, slog.Int("retry_after", apiErr.Parameters.RetryAfter))
+		}
 	}
-	var user User
-	err = json.Unmarshal(result, &user)
 	if err != nil {
-		return nil, err
+		attrs = append(attrs, slog.String("error", err.Error()))
 	}
-	return &user, err
+	logger.LogAttrs(ctx, level, "Bot API request", attrs...)
 }
 
-// Use this method to log out from the cloud Bot API server before launching the bot locally. You must log out the bot before running it locally,
-// otherwise there is no guarantee that the bot will receive updates. After a successful call, you can immediately log in on a local server,
-// but will not be able to log in back to the cloud Bot API server for 10 minutes. Returns nil on success. Requires no parameters.
-func (bot *Bot) LogOut() (err error) {
-	response := bot.MakeRequest("logQut", nil)
-	if !response.Ok {
-		return fmt.Errorf("function LogOut finished with error_code: %d, description: %s", response.ErrorCode, response.Description)
+func (bot *Bot) logger() *slog.Logger {
+	if bot.Logger != nil {
+		return bot.Logger
 	}
-	return
+	return slog.Default()
 }
 
-func (bot *Bot) Close() (err error) {
-	response := bot.MakeRequest("close", nil)
-	if !response.Ok {
-		return fmt.Errorf("function Close finished with error_code: %d, description: %s", response.ErrorCode, response.Description)
+// Replaces the bot token in s, so it can be logged or returned in an error
+func (bot *Bot) redact(s string) string {
+	if bot.Token == "" {
+		return s
 	}
-	return
+	return strings.ReplaceAll(s, bot.Token, "<token>")
+}
+
+// Returns err with the bot token removed from its message.
+// *url.Error values are copied with the token removed from their URL,
+// so the caller can still inspect them with errors.As.
+func (bot *Bot) redactError(err error) error {
+	if bot.Token == "" || !strings.Contains(err.Error(), bot.Token) {
+		return err
+	}
+	if urlErr, ok := err.(*url.Error); ok {
+		return &url.Error{Op: urlErr.Op, URL: bot.redact(urlErr.URL), Err: bot.redactError(urlErr.Err)}
+	}
+	var wrapped error
+	if unwrapped := errors.Unwrap(err); unwrapped != nil {
+		wrapped = bot.redactError(unwrapped)
+	}
+	return &redactedError{message: bot.redact(err.Error()), err: wrapped}
+}
+
+// redactedError replaces an error whose message contains the bot token.
+// It unwraps to the redacted version of the error the original one wrapped
+type redactedError struct {
+	message string
+	err     error
+}
+
+func (err *redactedError) Error() string {
+	return err.message
+}
+
+func (err *redactedError) Unwrap() error {
+	return err.err
+}
+
+func (bot *Bot) client() *http.Client {
+	if bot.Client != nil {
+		return bot.Client
+	}
+	return http.DefaultClient
 }
 
-func main() {
-	bot, err := NewBot(token())
+func (bot *Bot) serverURL() string {
+	if bot.ServerURL != "" {
+		return strings.TrimSuffix(bot.ServerURL, "/")
+	}
+	return DefaultServerURL
+}
+
+// Returns URL of the Bot API method
+func (bot *Bot) methodURL(method string) string {
+	return fmt.Sprintf("%s/bot%s/%s", bot.serverURL(), bot.Token, method)
+}
+
+// Returns URL to download the file with the given file_path from
+func (bot *Bot) fileURL(path string) string {
+	base := bot.serverURL() + "/file"
+	if bot.FileURL != "" {
+		base = strings.TrimSuffix(bot.FileURL, "/")
+	}
+	return fmt.Sprintf("%s/bot%s/%s", base, bot.Token, path)
+}
+
+// Call makes a request to the Bot API and decodes the result field of the response into T
+func Call[T any](ctx context.Context, bot *Bot, method string, params any) (T, error) {
+	var result T
+	response, err := bot.MakeRequest(ctx, method, params)
 	if err != nil {
-		fmt.Println(err)
-	} else {
-		fmt.Println(bot)
+		return result, err
+	}
+	if err := json.Unmarshal(response.Result, &result); err != nil {
+		return result, fmt.Errorf("method %s: decoding result: %w", method, err)
 	}
+	return result, nil
+}
+
+// A simple method for testing your bot's authentication token. Requires no parameters. Returns basic information about the bot in form of a User object.
+func (bot *Bot) GetMe(ctx context.Context) (*User, error) {
+	return Call[*User](ctx, bot, "getMe", nil)
+}
+
+// Use this method to log out from the cloud Bot API server before launching the bot locally. You must log out the bot before running it locally,
+// otherwise there is no guarantee that the bot will receive updates. After a successful call, you can immediately log in on a local server,
+// but will not be able to log in back to the cloud Bot API server for 10 minutes. Returns nil on success. Requires no parameters.
+func (bot *Bot) LogOut(ctx context.Context) (err error) {
+	_, err = Call[bool](ctx, bot, "logOut", nil)
+	return
+}
+
+func (bot *Bot) Close(ctx context.Context) (err error) {
+	_, err = Call[bool](ctx, bot, "close", nil)
+	return
 }
@@ -0,0 +1,69 @@
+package gogram
+
+import "encoding/json"
+
+// BotCommandScope represents the scope to which bot commands are applied. It is implemented by
+// BotCommandScopeDefault, BotCommandScopeAllPrivateChats, BotCommandScopeAllGroupChats,
+// BotCommandScopeAllChatAdministrators, BotCommandScopeChat, BotCommandScopeChatAdministrators
+// and BotCommandScopeChatMember, whose type field is filled in when they are marshalled.
+type BotCommandScope interface {
+	// Returns the value of the type field
+	botCommandScopeType() string
+}
+
+func (BotCommandScopeDefault) botCommandScopeType() string         { return "default" }
+func (BotCommandScopeAllPrivateChats) botCommandScopeType() string { return "all_private_chats" }
+func (BotCommandScopeAllGroupChats) botCommandScopeType() string   { return "all_group_chats" }
+func (BotCommandScopeAllChatAdministrators) botCommandScopeType() string {
+	return "all_chat_administrators"
+}
+func (BotCommandScopeChat) botCommandScopeType() string               { return "chat" }
+func (BotCommandScopeChatAdministrators) botCommandScopeType() string { return "chat_administrators" }
+func (BotCommandScopeChatMember) botCommandScopeType() string         { return "chat_member" }
+
+func (scope BotCommandScopeDefault) MarshalJSON() ([]byte, error) {
+	return marshalType(scope.botCommandScopeType())
+}
+
+func (scope BotCommandScopeAllPrivateChats) MarshalJSON() ([]byte, error) {
+	return marshalType(scope.botCommandScopeType())
+}
+
+func (scope BotCommandScopeAllGroupChats) MarshalJSON() ([]byte, error) {
+	return marshalType(scope.botCommandScopeType())
+}
+
+func (scope BotCommandScopeAllChatAdministrators) MarshalJSON() ([]byte, error) {
+	return marshalType(scope.botCommandScopeType())
+}
+
+func (scope BotCommandScopeChat) MarshalJSON() ([]byte, error) {
+	type botCommandScopeChat BotCommandScopeChat
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		botCommandScopeChat
+	}{scope.botCommandScopeType(), botCommandScopeChat(scope)})
+}
+
+func (scope BotCommandScopeChatAdministrators) MarshalJSON() ([]byte, error) {
+	type botCommandScopeChatAdministrators BotCommandScopeChatAdministrators
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		botCommandScopeChatAdministrators
+	}{scope.botCommandScopeType(), botCommandScopeChatAdministrators(scope)})
+}
+
+func (scope BotCommandScopeChatMember) MarshalJSON() ([]byte, error) {
+	type botCommandScopeChatMember BotCommandScopeChatMember
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		botCommandScopeChatMember
+	}{scope.botCommandScopeType(), botCommandScopeChatMember(scope)})
+}
+
+// Marshals an object which has no fields other than type
+func marshalType(typ string) ([]byte, error) {
+	return json.Marshal(struct {
+		Type string `json:"type"`
+	}{typ})
+}
@@ -0,0 +1,254 @@
+package gogram
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Maximum size of InlineKeyboardButton.CallbackData in bytes
+const MaxCallbackDataSize = 64
+
+var (
+	// Returned, wrapped, when encoded callback data doesn't fit into MaxCallbackDataSize bytes
+	ErrCallbackDataTooLong = errors.New("gogram: callback data is longer than 64 bytes")
+
+	// Returned, wrapped, when callback data can't be decoded or its signature doesn't match
+	ErrInvalidCallbackData = errors.New("gogram: invalid callback data")
+)
+
+// Number of bytes of the HMAC-SHA256 kept in signed callback data
+const callbackSignatureSize = 6
+
+// Escapes the separator in string fields
+var (
+	callbackEscaper   = strings.NewReplacer("%", "%25", ":", "%3A")
+	callbackUnescaper = strings.NewReplacer("%3A", ":", "%25", "%")
+)
+
+// CallbackData encodes values of the struct type T as callback data of inline keyboard buttons
+// and decodes them back. The data is the prefix followed by the exported fields of T in the order
+// they are declared, separated by colons, like “vote:1k:up”; integers are written in base 36
+// to save space. Fields tagged `callback:"-"` are skipped.
+//
+// If a key is given, the data is signed with HMAC-SHA256, so users can't forge it.
+type CallbackData[T any] struct {
+	prefix string
+	key    []byte
+	fields []int
+}
+
+// NewCallbackData returns the codec for T, whose data starts with prefix. If key is not nil,
+// the data is signed with it. It panics if prefix contains a colon, T is not a struct of strings,
+// booleans, integers and floats, or the data can be longer than MaxCallbackDataSize bytes
+// even with empty strings, as integers take up to 14 bytes each and floats up to 24.
+// Strings are counted as empty, so the length of layouts with strings is only checked by Encode.
+func NewCallbackData[T any](prefix string, key []byte) *CallbackData[T] {
+	if prefix == "" || strings.Contains(prefix, ":") {
+		panic(fmt.Sprintf("gogram: invalid callback data prefix %q", prefix))
+	}
+	structType := reflect.TypeOf((*T)(nil)).Elem()
+	if structType.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("gogram: callback data must be a struct, got %s", structType))
+	}
+
+	codec := &CallbackData[T]{prefix: prefix, key: key}
+	// Length of the data with the longest integers and the shortest strings and floats
+	size := len(prefix)
+	if key != nil {
+		size += 1 + base64.RawURLEncoding.EncodedLen(callbackSignatureSize)
+	}
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !field.IsExported() || field.Tag.Get("callback") == "-" {
+			continue
+		}
+		switch field.Type.Kind() {
+		case reflect.String, reflect.Bool,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+		default:
+			panic(fmt.Sprintf("gogram: unsupported type %s of callback data field %s", field.Type, field.Name))
+		}
+		codec.fields = append(codec.fields, i)
+		size += 1 + callbackFieldMaxSize(field.Type)
+	}
+	if size > MaxCallbackDataSize {
+		panic(fmt.Sprintf("gogram: callback data %q can be %d bytes long, more than %d", prefix, size, MaxCallbackDataSize))
+	}
+	return codec
+}
+
+// Prefix returns the prefix the data starts with
+func (codec *CallbackData[T]) Prefix() string {
+	return codec.prefix
+}
+
+// Encode returns value encoded as callback data. It returns an error wrapping
+// ErrCallbackDataTooLong if the data is longer than MaxCallbackDataSize bytes.
+func (codec *CallbackData[T]) Encode(value T) (string, error) {
+	structValue := reflect.ValueOf(value)
+	parts := []string{codec.prefix}
+	for _, i := range codec.fields {
+		parts = append(parts, encodeCallbackField(structValue.Field(i)))
+	}
+	data := strings.Join(parts, ":")
+	if codec.key != nil {
+		data += ":" + codec.sign(data)
+	}
+	if len(data) > MaxCallbackDataSize {
+		return "", fmt.Errorf("%w: %q is %d bytes", ErrCallbackDataTooLong, data, len(data))
+	}
+	return data, nil
+}
+
+// Button returns an inline keyboard button with text that sends value as its callback data
+func (codec *CallbackData[T]) Button(text string, value T) (InlineKeyboardButton, error) {
+	data, err := codec.Encode(value)
+	if err != nil {
+		return InlineKeyboardButton{}, err
+	}
+	return InlineKeyboardButton{Text: text, CallbackData: data}, nil
+}
+
+// Match reports whether data starts with the prefix of the codec
+func (codec *CallbackData[T]) Match(data string) bool {
+	return strings.HasPrefix(data, codec.prefix+":") || data == codec.prefix
+}
+
+// Decode decodes callback data made by Encode. It returns an error wrapping ErrInvalidCallbackData
+// if the data has another prefix, a wrong number of fields or a wrong signature.
+func (codec *CallbackData[T]) Decode(data string) (T, error) {
+	var value T
+	parts := strings.Split(data, ":")
+	if parts[0] != codec.prefix {
+		return value, fmt.Errorf("%w: prefix is not %q", ErrInvalidCallbackData, codec.prefix)
+	}
+	if codec.key != nil {
+		last := len(parts) - 1
+		signed := strings.Join(parts[:last], ":")
+		if last == 0 || !hmac.Equal([]byte(parts[last]), []byte(codec.sign(signed))) {
+			return value, fmt.Errorf("%w: signature doesn't match", ErrInvalidCallbackData)
+		}
+		parts = parts[:last]
+	}
+	if len(parts)-1 != len(codec.fields) {
+		return value, fmt.Errorf("%w: expected %d fields, got %d", ErrInvalidCallbackData, len(codec.fields), len(parts)-1)
+	}
+
+	structValue := reflect.ValueOf(&value).Elem()
+	for n, i := range codec.fields {
+		if err := decodeCallbackField(structValue.Field(i), parts[n+1]); err != nil {
+			return value, fmt.Errorf("%w: field %s: %v", ErrInvalidCallbackData, structValue.Type().Field(i).Name, err)
+		}
+	}
+	return value, nil
+}
+
+// Returns the signature of data
+func (codec *CallbackData[T]) sign(data string) string {
+	mac := hmac.New(sha256.New, codec.key)
+	mac.Write([]byte(data))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:callbackSignatureSize])
+}
+
+// Returns the length of the longest encoded value of a field of type t,
+// or of the shortest one for strings, whose length is not bounded
+func callbackFieldMaxSize(t reflect.Type) int {
+	switch t.Kind() {
+	case reflect.Bool:
+		return 1
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return len(strconv.FormatInt(-1<<(t.Bits()-1), 36))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return len(strconv.FormatUint(1<<t.Bits()-1, 36))
+	case reflect.Float32:
+		// Like “-1.00053455e-36”
+		return 15
+	case reflect.Float64:
+		// Like “-2.2250738585072014e-308”
+		return 24
+	}
+	return 0
+}
+
+func encodeCallbackField(field reflect.Value) string {
+	switch field.Kind() {
+	case reflect.String:
+		return callbackEscaper.Replace(field.String())
+	case reflect.Bool:
+		if field.Bool() {
+			return "1"
+		}
+		return "0"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(field.Int(), 36)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(field.Uint(), 36)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(field.Float(), 'g', -1, field.Type().Bits())
+	}
+	panic("unreachable")
+}
+
+func decodeCallbackField(field reflect.Value, s string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(callbackUnescaper.Replace(s))
+	case reflect.Bool:
+		switch s {
+		case "1":
+			field.SetBool(true)
+		case "0":
+			field.SetBool(false)
+		default:
+			return fmt.Errorf("invalid boolean %q", s)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 36, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", s)
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 36, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid number %q", s)
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid number %q", s)
+		}
+		field.SetFloat(f)
+	}
+	return nil
+}
+
+// Filter returns a filter which passes callback queries whose data starts with the prefix of the codec
+func (codec *CallbackData[T]) Filter() Filter {
+	return func(ctx *Context) bool {
+		query := ctx.Update.CallbackQuery
+		return query != nil && codec.Match(query.Data)
+	}
+}
+
+// OnCallback registers handler for callback queries with data made by codec and passing all of filters.
+// The handler receives the decoded value. Data that can't be decoded, like data with a wrong signature,
+// is reported as an error instead of calling the handler.
+func OnCallback[T any](group *HandlerGroup, codec *CallbackData[T], handler func(ctx *Context, value T) error, filters ...Filter) {
+	group.OnCallbackQuery(func(ctx *Context) error {
+		value, err := codec.Decode(ctx.Update.CallbackQuery.Data)
+		if err != nil {
+			return err
+		}
+		return handler(ctx, value)
+	}, append([]Filter{codec.Filter()}, filters...)...)
+}
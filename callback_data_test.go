@@ -0,0 +1,140 @@
+package gogram
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+type testVote struct {
+	PollId  int64
+	Option  uint8
+	Up      bool
+	Comment string
+	Weight  float64
+	skipped int
+	Ignored string `callback:"-"`
+}
+
+func TestCallbackDataRoundTrip(t *testing.T) {
+	values := []testVote{
+		{},
+		{PollId: 42, Option: 3, Up: true, Comment: "nice", Weight: 0.5},
+		{PollId: math.MinInt64, Option: math.MaxUint8, Weight: -1e10},
+		{PollId: math.MaxInt64, Comment: "a:b%3A%c"},
+	}
+	for _, key := range [][]byte{nil, []byte("secret")} {
+		codec := NewCallbackData[testVote]("vote", key)
+		for _, value := range values {
+			data, err := codec.Encode(value)
+			if err != nil {
+				t.Fatalf("Encode(%+v) error = %v", value, err)
+			}
+			if !codec.Match(data) {
+				t.Errorf("Match(%q) = false", data)
+			}
+			got, err := codec.Decode(data)
+			if err != nil {
+				t.Fatalf("Decode(%q) error = %v", data, err)
+			}
+			if got != value {
+				t.Errorf("Decode(Encode(%+v)) = %+v", value, got)
+			}
+		}
+	}
+}
+
+func TestCallbackDataFormat(t *testing.T) {
+	codec := NewCallbackData[testVote]("vote", nil)
+	data, err := codec.Encode(testVote{PollId: 42, Option: 36, Up: true, Comment: "a:b%c", Weight: 1.5, Ignored: "x"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "vote:16:10:1:a%3Ab%25c:1.5"; data != want {
+		t.Errorf("Encode() = %q, want %q", data, want)
+	}
+}
+
+func TestCallbackDataInvalid(t *testing.T) {
+	signed := NewCallbackData[testVote]("vote", []byte("secret"))
+	data, err := signed.Encode(testVote{PollId: 42})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cut := strings.LastIndex(data, ":")
+	tampered := strings.Replace(data[:cut], "vote:16", "vote:17", 1) + data[cut:]
+
+	plain := NewCallbackData[testVote]("vote", nil)
+	tests := []struct {
+		name  string
+		codec *CallbackData[testVote]
+		data  string
+	}{
+		{"tampered field", signed, tampered},
+		{"tampered signature", signed, data[:cut+1] + "AAAAAAAA"},
+		{"missing signature", signed, data[:cut]},
+		{"other key", NewCallbackData[testVote]("vote", []byte("other")), data},
+		{"other prefix", plain, "poll:16:0:0::0"},
+		{"too few fields", plain, "vote:16:0"},
+		{"too many fields", plain, "vote:16:0:0::0:1"},
+		{"invalid integer", plain, "vote:!:0:0::0"},
+		{"integer out of range", plain, "vote:16:zz:0::0"},
+		{"invalid boolean", plain, "vote:16:0:yes::0"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := test.codec.Decode(test.data); !errors.Is(err, ErrInvalidCallbackData) {
+				t.Errorf("Decode(%q) error = %v, want ErrInvalidCallbackData", test.data, err)
+			}
+		})
+	}
+}
+
+func TestCallbackDataTooLong(t *testing.T) {
+	codec := NewCallbackData[testVote]("vote", []byte("secret"))
+	_, err := codec.Encode(testVote{Comment: strings.Repeat("x", MaxCallbackDataSize)})
+	if !errors.Is(err, ErrCallbackDataTooLong) {
+		t.Errorf("Encode() error = %v, want ErrCallbackDataTooLong", err)
+	}
+	if _, err := codec.Button("Vote", testVote{Comment: strings.Repeat("x", MaxCallbackDataSize)}); !errors.Is(err, ErrCallbackDataTooLong) {
+		t.Errorf("Button() error = %v, want ErrCallbackDataTooLong", err)
+	}
+}
+
+type testWideCallback struct {
+	A, B, C, D int64
+}
+
+func TestNewCallbackDataPanics(t *testing.T) {
+	tests := []struct {
+		name string
+		new  func()
+	}{
+		{"empty prefix", func() { NewCallbackData[testVote]("", nil) }},
+		{"prefix with colon", func() { NewCallbackData[testVote]("a:b", nil) }},
+		{"not a struct", func() { NewCallbackData[int]("n", nil) }},
+		{"unsupported field", func() { NewCallbackData[struct{ Ids []int }]("ids", nil) }},
+		// 4 + 4 * (1 + 14) + 1 + 8 bytes for the largest negative integers and the signature
+		{"integers too long", func() { NewCallbackData[testWideCallback]("wide", []byte("secret")) }},
+		// 29 + 3 * (1 + 24) bytes for the longest floats
+		{"floats too long", func() { NewCallbackData[struct{ A, B, C float64 }]("prefix_that_is_long_enough_xx", nil) }},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("NewCallbackData didn't panic")
+				}
+			}()
+			test.new()
+		})
+	}
+
+	// The same layout fits without the signature: 4 + 4 * 15 = 64 bytes
+	codec := NewCallbackData[testWideCallback]("wide", nil)
+	value := testWideCallback{math.MinInt64, math.MinInt64, math.MinInt64, math.MinInt64}
+	if data, err := codec.Encode(value); err != nil || len(data) != MaxCallbackDataSize {
+		t.Errorf("Encode() = %q, %v, want %d bytes", data, err, MaxCallbackDataSize)
+	}
+}
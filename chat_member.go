@@ -0,0 +1,276 @@
+package gogram
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// The member's status in the chat
+const (
+	ChatMemberStatusCreator       = "creator"
+	ChatMemberStatusAdministrator = "administrator"
+	ChatMemberStatusMember        = "member"
+	ChatMemberStatusRestricted    = "restricted"
+	ChatMemberStatusLeft          = "left"
+	ChatMemberStatusKicked        = "kicked"
+)
+
+// ChatMember contains information about one member of a chat. It is implemented by
+// *ChatMemberOwner, *ChatMemberAdministrator, *ChatMemberMember,
+// *ChatMemberRestricted, *ChatMemberLeft and *ChatMemberBanned, and by *ChatMemberUnknown
+// for statuses added to the Bot API after this library was written.
+type ChatMember interface {
+	// Returns the member's status in the chat, one of the ChatMemberStatus constants
+	MemberStatus() string
+
+	// Returns information about the user
+	MemberUser() *User
+
+	// Reports whether the user is a member of the chat at the moment
+	IsMember() bool
+
+	// Returns what the user is allowed to do in the chat.
+	// nil means that the default permissions of the chat apply to the user
+	Permissions() *ChatPermissions
+}
+
+// ChatMemberUnknown represents a chat member with a status this library doesn't know.
+// Nothing is known about what the user can do in the chat.
+type ChatMemberUnknown struct {
+	// The member's status in the chat
+	Status string `json:"status"`
+
+	// Information about the user
+	User *User `json:"user"`
+
+	// The member as sent by the Bot API
+	Raw json.RawMessage `json:"-"`
+}
+
+func (member *ChatMemberOwner) MemberStatus() string         { return ChatMemberStatusCreator }
+func (member *ChatMemberAdministrator) MemberStatus() string { return ChatMemberStatusAdministrator }
+func (member *ChatMemberMember) MemberStatus() string        { return ChatMemberStatusMember }
+func (member *ChatMemberRestricted) MemberStatus() string    { return ChatMemberStatusRestricted }
+func (member *ChatMemberLeft) MemberStatus() string          { return ChatMemberStatusLeft }
+func (member *ChatMemberBanned) MemberStatus() string        { return ChatMemberStatusKicked }
+func (member *ChatMemberUnknown) MemberStatus() string       { return member.Status }
+
+func (member *ChatMemberOwner) MemberUser() *User         { return member.User }
+func (member *ChatMemberAdministrator) MemberUser() *User { return member.User }
+func (member *ChatMemberMember) MemberUser() *User        { return member.User }
+func (member *ChatMemberRestricted) MemberUser() *User    { return member.User }
+func (member *ChatMemberLeft) MemberUser() *User          { return member.User }
+func (member *ChatMemberBanned) MemberUser() *User        { return member.User }
+func (member *ChatMemberUnknown) MemberUser() *User       { return member.User }
+
+func (member *ChatMemberOwner) IsMember() bool         { return true }
+func (member *ChatMemberAdministrator) IsMember() bool { return true }
+func (member *ChatMemberMember) IsMember() bool        { return true }
+func (member *ChatMemberRestricted) IsMember() bool    { return member.Member }
+func (member *ChatMemberLeft) IsMember() bool          { return false }
+func (member *ChatMemberBanned) IsMember() bool        { return false }
+func (member *ChatMemberUnknown) IsMember() bool       { return false }
+
+// The owner can do everything in the chat
+func (member *ChatMemberOwner) Permissions() *ChatPermissions {
+	return allChatPermissions()
+}
+
+// Administrators can send everything and have the rest of the permissions granted by their rights
+func (member *ChatMemberAdministrator) Permissions() *ChatPermissions {
+	permissions := allChatPermissions()
+	permissions.CanChangeInfo = member.CanChangeInfo
+	permissions.CanInviteUsers = member.CanInviteUsers
+	permissions.CanPinMessages = member.CanPinMessages
+	permissions.CanManageTopics = member.CanManageTopics
+	return permissions
+}
+
+// Ordinary members have the default permissions of the chat
+func (member *ChatMemberMember) Permissions() *ChatPermissions {
+	return nil
+}
+
+func (member *ChatMemberRestricted) Permissions() *ChatPermissions {
+	return &ChatPermissions{
+		CanSendMessages:       member.CanSendMessages,
+		CanSendAudios:         member.CanSendAudios,
+		CanSendDocuments:      member.CanSendDocuments,
+		CanSendPhotos:         member.CanSendPhotos,
+		CanSendVideos:         member.CanSendVideos,
+		CanSendVideoNotes:     member.CanSendVideoNotes,
+		CanSendVoiceNotes:     member.CanSendVoiceNotes,
+		CanSendPolls:          member.CanSendPolls,
+		CanSendOtherMessages:  member.CanSendOtherMessages,
+		CanAddWebPagePreviews: member.CanAddWebPagePreviews,
+		CanChangeInfo:         member.CanChangeInfo,
+		CanInviteUsers:        member.CanInviteUsers,
+		CanPinMessages:        member.CanPinMessages,
+		CanManageTopics:       member.CanManageTopics,
+	}
+}
+
+// Users who left the chat can't do anything in it
+func (member *ChatMemberLeft) Permissions() *ChatPermissions {
+	return &ChatPermissions{}
+}
+
+// Banned users can't do anything in the chat
+func (member *ChatMemberBanned) Permissions() *ChatPermissions {
+	return &ChatPermissions{}
+}
+
+// Nothing is known to be allowed to members with an unknown status
+func (member *ChatMemberUnknown) Permissions() *ChatPermissions {
+	return &ChatPermissions{}
+}
+
+func allChatPermissions() *ChatPermissions {
+	return &ChatPermissions{
+		CanSendMessages:       true,
+		CanSendAudios:         true,
+		CanSendDocuments:      true,
+		CanSendPhotos:         true,
+		CanSendVideos:         true,
+		CanSendVideoNotes:     true,
+		CanSendVoiceNotes:     true,
+		CanSendPolls:          true,
+		CanSendOtherMessages:  true,
+		CanAddWebPagePreviews: true,
+		CanChangeInfo:         true,
+		CanInviteUsers:        true,
+		CanPinMessages:        true,
+		CanManageTopics:       true,
+	}
+}
+
+// Decodes a ChatMember choosing its type by the status field.
+// A member with an unknown status is decoded as *ChatMemberUnknown.
+func unmarshalChatMember(data []byte) (ChatMember, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var header struct {
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+
+	var member ChatMember
+	switch header.Status {
+	case ChatMemberStatusCreator:
+		member = &ChatMemberOwner{}
+	case ChatMemberStatusAdministrator:
+		member = &ChatMemberAdministrator{}
+	case ChatMemberStatusMember:
+		member = &ChatMemberMember{}
+	case ChatMemberStatusRestricted:
+		member = &ChatMemberRestricted{}
+	case ChatMemberStatusLeft:
+		member = &ChatMemberLeft{}
+	case ChatMemberStatusKicked:
+		member = &ChatMemberBanned{}
+	default:
+		member = &ChatMemberUnknown{Raw: append(json.RawMessage(nil), data...)}
+	}
+	if err := json.Unmarshal(data, member); err != nil {
+		return nil, fmt.Errorf("decoding chat member with status %q: %w", header.Status, err)
+	}
+	return member, nil
+}
+
+func (update *ChatMemberUpdated) UnmarshalJSON(data []byte) error {
+	type chatMemberUpdated ChatMemberUpdated
+	var raw struct {
+		chatMemberUpdated
+		OldChatMember json.RawMessage `json:"old_chat_member"`
+		NewChatMember json.RawMessage `json:"new_chat_member"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*update = ChatMemberUpdated(raw.chatMemberUpdated)
+
+	var err error
+	if update.OldChatMember, err = unmarshalChatMember(raw.OldChatMember); err != nil {
+		return err
+	}
+	if update.NewChatMember, err = unmarshalChatMember(raw.NewChatMember); err != nil {
+		return err
+	}
+	return nil
+}
+
+// The helpers below report false when either status is missing or unknown,
+// as nothing can be said about such a change.
+
+// Joined reports whether the user became a member of the chat
+func (update *ChatMemberUpdated) Joined() bool {
+	return update.known() && !isMember(update.OldChatMember) && isMember(update.NewChatMember)
+}
+
+// Left reports whether the user stopped being a member of the chat, by leaving it or being banned
+func (update *ChatMemberUpdated) Left() bool {
+	return update.known() && isMember(update.OldChatMember) && !isMember(update.NewChatMember)
+}
+
+// Promoted reports whether the user became an administrator of the chat
+func (update *ChatMemberUpdated) Promoted() bool {
+	return update.known() && !isAdministrator(update.OldChatMember) && isAdministrator(update.NewChatMember)
+}
+
+// Demoted reports whether the user stopped being an administrator of the chat
+func (update *ChatMemberUpdated) Demoted() bool {
+	return update.known() && isAdministrator(update.OldChatMember) && !isAdministrator(update.NewChatMember)
+}
+
+// Banned reports whether the user was banned in the chat
+func (update *ChatMemberUpdated) Banned() bool {
+	return update.known() && !hasStatus(update.OldChatMember, ChatMemberStatusKicked) && hasStatus(update.NewChatMember, ChatMemberStatusKicked)
+}
+
+// Restricted reports whether the user was restricted in the chat
+func (update *ChatMemberUpdated) Restricted() bool {
+	return update.known() && hasStatus(update.NewChatMember, ChatMemberStatusRestricted)
+}
+
+// Reports whether both statuses are present and known
+func (update *ChatMemberUpdated) known() bool {
+	return isKnown(update.OldChatMember) && isKnown(update.NewChatMember)
+}
+
+func isKnown(member ChatMember) bool {
+	_, unknown := member.(*ChatMemberUnknown)
+	return member != nil && !unknown
+}
+
+func isMember(member ChatMember) bool {
+	return member != nil && member.IsMember()
+}
+
+func isAdministrator(member ChatMember) bool {
+	return hasStatus(member, ChatMemberStatusAdministrator) || hasStatus(member, ChatMemberStatusCreator)
+}
+
+func hasStatus(member ChatMember, status string) bool {
+	return member != nil && member.MemberStatus() == status
+}
+
+// Until returns the date when restrictions will be lifted for the user, or the zero time if they are restricted forever
+func (member *ChatMemberRestricted) Until() time.Time {
+	return unixTime(member.UntilDate)
+}
+
+// Until returns the date when the user will be unbanned, or the zero time if they are banned forever
+func (member *ChatMemberBanned) Until() time.Time {
+	return unixTime(member.UntilDate)
+}
+
+func unixTime(date int) time.Time {
+	if date == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(date), 0)
+}
@@ -0,0 +1,184 @@
+package gogram
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// A chat member of every status, as sent by the Bot API
+var chatMemberFixtures = map[string]string{
+	ChatMemberStatusCreator: `{"status":"creator","user":{"id":1,"is_bot":false,"first_name":"Owner"},
+		"is_anonymous":false,"custom_title":"Boss"}`,
+	ChatMemberStatusAdministrator: `{"status":"administrator","user":{"id":1,"is_bot":false,"first_name":"Admin"},
+		"can_be_edited":false,"is_anonymous":false,"can_manage_chat":true,"can_delete_messages":true,
+		"can_manage_video_chats":true,"can_restrict_members":true,"can_promote_members":false,
+		"can_change_info":true,"can_invite_users":true,"can_pin_messages":false}`,
+	ChatMemberStatusMember: `{"status":"member","user":{"id":1,"is_bot":false,"first_name":"Member"}}`,
+	ChatMemberStatusRestricted: `{"status":"restricted","user":{"id":1,"is_bot":false,"first_name":"Muted"},
+		"is_member":true,"can_send_messages":false,"can_send_photos":true,"until_date":1700000000}`,
+	ChatMemberStatusLeft:   `{"status":"left","user":{"id":1,"is_bot":false,"first_name":"Gone"}}`,
+	ChatMemberStatusKicked: `{"status":"kicked","user":{"id":1,"is_bot":false,"first_name":"Banned"},"until_date":0}`,
+	testUnknownStatus:      `{"status":"overlord","user":{"id":1,"is_bot":false,"first_name":"Future"},"is_member":true}`,
+}
+
+// A status the library doesn't know, as if it were added to the Bot API later
+const testUnknownStatus = "overlord"
+
+func TestUnmarshalChatMember(t *testing.T) {
+	tests := []struct {
+		status   string
+		wantType reflect.Type
+		isMember bool
+	}{
+		{ChatMemberStatusCreator, reflect.TypeOf(&ChatMemberOwner{}), true},
+		{ChatMemberStatusAdministrator, reflect.TypeOf(&ChatMemberAdministrator{}), true},
+		{ChatMemberStatusMember, reflect.TypeOf(&ChatMemberMember{}), true},
+		{ChatMemberStatusRestricted, reflect.TypeOf(&ChatMemberRestricted{}), true},
+		{ChatMemberStatusLeft, reflect.TypeOf(&ChatMemberLeft{}), false},
+		{ChatMemberStatusKicked, reflect.TypeOf(&ChatMemberBanned{}), false},
+		{testUnknownStatus, reflect.TypeOf(&ChatMemberUnknown{}), false},
+	}
+	for _, test := range tests {
+		t.Run(test.status, func(t *testing.T) {
+			member, err := unmarshalChatMember([]byte(chatMemberFixtures[test.status]))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if reflect.TypeOf(member) != test.wantType {
+				t.Fatalf("decoded %T, want %s", member, test.wantType)
+			}
+			if member.MemberStatus() != test.status {
+				t.Errorf("MemberStatus() = %q, want %q", member.MemberStatus(), test.status)
+			}
+			if member.MemberUser() == nil || member.MemberUser().Id != 1 {
+				t.Errorf("MemberUser() = %+v, want user 1", member.MemberUser())
+			}
+			if member.IsMember() != test.isMember {
+				t.Errorf("IsMember() = %v, want %v", member.IsMember(), test.isMember)
+			}
+		})
+	}
+}
+
+func TestUnmarshalChatMemberFields(t *testing.T) {
+	member, err := unmarshalChatMember([]byte(chatMemberFixtures[ChatMemberStatusRestricted]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	restricted := member.(*ChatMemberRestricted)
+	if !restricted.Member {
+		t.Error("Member = false, want true")
+	}
+	if !restricted.Until().Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("Until() = %s, want %s", restricted.Until(), time.Unix(1700000000, 0))
+	}
+	if permissions := member.Permissions(); permissions.CanSendMessages || !permissions.CanSendPhotos {
+		t.Errorf("Permissions() = %+v, want photos only", permissions)
+	}
+
+	member, err = unmarshalChatMember([]byte(chatMemberFixtures[ChatMemberStatusKicked]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if until := member.(*ChatMemberBanned).Until(); !until.IsZero() {
+		t.Errorf("Until() of a permanent ban = %s, want the zero time", until)
+	}
+
+	member, err = unmarshalChatMember([]byte(chatMemberFixtures[ChatMemberStatusAdministrator]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if permissions := member.Permissions(); !permissions.CanSendMessages || !permissions.CanChangeInfo || permissions.CanPinMessages {
+		t.Errorf("Permissions() = %+v, want everything but pinning messages", permissions)
+	}
+}
+
+func TestUnmarshalChatMemberUnknown(t *testing.T) {
+	for _, data := range []string{``, `null`} {
+		member, err := unmarshalChatMember([]byte(data))
+		if member != nil || err != nil {
+			t.Errorf("unmarshalChatMember(%q) = %v, %v, want nil, nil", data, member, err)
+		}
+	}
+	data := chatMemberFixtures[testUnknownStatus]
+	member, err := unmarshalChatMember([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	unknown := member.(*ChatMemberUnknown)
+	if unknown.Status != testUnknownStatus || string(unknown.Raw) != data {
+		t.Errorf("unmarshalChatMember() = %+v, want status %q and the raw member", unknown, testUnknownStatus)
+	}
+	if *unknown.Permissions() != (ChatPermissions{}) {
+		t.Errorf("Permissions() = %+v, want nothing allowed", unknown.Permissions())
+	}
+	if _, err := unmarshalChatMember([]byte(`{"status":"member","user":"nobody"}`)); err == nil {
+		t.Error("unmarshalChatMember() of an invalid member succeeded")
+	}
+}
+
+// Returns a chat_member update moving the user from the old status to the new one
+func chatMemberUpdate(t *testing.T, oldStatus, newStatus string) *ChatMemberUpdated {
+	t.Helper()
+	data := `{"chat":{"id":-100,"type":"supergroup"},"from":{"id":2,"is_bot":false,"first_name":"Admin"},"date":1700000000,` +
+		`"old_chat_member":` + chatMemberFixtures[oldStatus] + `,"new_chat_member":` + chatMemberFixtures[newStatus] + `}`
+	var update ChatMemberUpdated
+	if err := json.Unmarshal([]byte(data), &update); err != nil {
+		t.Fatal(err)
+	}
+	return &update
+}
+
+func TestChatMemberUpdated(t *testing.T) {
+	tests := []struct {
+		old, new                                          string
+		joined, left, promoted, demoted, banned, restrict bool
+	}{
+		{old: ChatMemberStatusLeft, new: ChatMemberStatusMember, joined: true},
+		{old: ChatMemberStatusKicked, new: ChatMemberStatusMember, joined: true},
+		{old: ChatMemberStatusMember, new: ChatMemberStatusLeft, left: true},
+		{old: ChatMemberStatusMember, new: ChatMemberStatusKicked, left: true, banned: true},
+		{old: ChatMemberStatusMember, new: ChatMemberStatusAdministrator, promoted: true},
+		{old: ChatMemberStatusAdministrator, new: ChatMemberStatusMember, demoted: true},
+		{old: ChatMemberStatusAdministrator, new: ChatMemberStatusCreator},
+		{old: ChatMemberStatusMember, new: ChatMemberStatusRestricted, restrict: true},
+		{old: ChatMemberStatusLeft, new: ChatMemberStatusRestricted, joined: true, restrict: true},
+		// Nothing is reported about changes from or to a status the library doesn't know
+		{old: ChatMemberStatusMember, new: testUnknownStatus},
+		{old: ChatMemberStatusAdministrator, new: testUnknownStatus},
+		{old: testUnknownStatus, new: ChatMemberStatusMember},
+		{old: testUnknownStatus, new: ChatMemberStatusKicked},
+	}
+	for _, test := range tests {
+		t.Run(test.old+" to "+test.new, func(t *testing.T) {
+			update := chatMemberUpdate(t, test.old, test.new)
+			if update.Chat == nil || update.Chat.Id != -100 || update.From == nil || update.From.Id != 2 {
+				t.Errorf("other fields not decoded: %+v", update)
+			}
+			if status := update.OldChatMember.MemberStatus(); status != test.old {
+				t.Errorf("OldChatMember status = %q, want %q", status, test.old)
+			}
+			if status := update.NewChatMember.MemberStatus(); status != test.new {
+				t.Errorf("NewChatMember status = %q, want %q", status, test.new)
+			}
+			checks := []struct {
+				name      string
+				got, want bool
+			}{
+				{"Joined", update.Joined(), test.joined},
+				{"Left", update.Left(), test.left},
+				{"Promoted", update.Promoted(), test.promoted},
+				{"Demoted", update.Demoted(), test.demoted},
+				{"Banned", update.Banned(), test.banned},
+				{"Restricted", update.Restricted(), test.restrict},
+			}
+			for _, check := range checks {
+				if check.got != check.want {
+					t.Errorf("%s() = %v, want %v", check.name, check.got, check.want)
+				}
+			}
+		})
+	}
+}
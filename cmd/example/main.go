@@ -0,0 +1,32 @@
+// Command example starts a bot and prints the information returned by getMe.
+//
+// The bot token is taken from the -token flag or, if the flag is empty,
+// from the TELEGRAM_BOT_TOKEN environment variable.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/Ivanprogram-creaator/Gogram"
+)
+
+func main() {
+	token := flag.String("token", os.Getenv("TELEGRAM_BOT_TOKEN"), "bot token issued by @BotFather")
+	server := flag.String("server", "", "base URL of a local Bot API server")
+	debug := flag.Bool("debug", false, "log every request")
+	flag.Parse()
+
+	if *token == "" {
+		fmt.Fprintln(os.Stderr, "bot token is required: pass -token or set TELEGRAM_BOT_TOKEN")
+		os.Exit(2)
+	}
+
+	bot, err := gogram.NewBot(*token, gogram.WithServerURL(*server), gogram.WithDebug(*debug))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Printf("Authorized as @%s (id %d)\n", bot.Self.Username, bot.Self.Id)
+}
@@ -0,0 +1,314 @@
+package gogram
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf16"
+)
+
+// Returned, wrapped, by Command.Arguments and Command.Bind when the arguments can't be parsed
+var ErrInvalidArguments = errors.New("gogram: invalid command arguments")
+
+// Command is a bot command a message starts with, like “/ban@MyBot @user 1h spam”
+type Command struct {
+	// Name of the command without the leading slash, like “ban”
+	Name string
+
+	// Username of the bot the command is addressed to, like “MyBot”. Empty if it isn't addressed to a bot
+	Mention string
+
+	// Text following the command, with leading and trailing spaces removed, like “@user 1h spam”
+	Args string
+}
+
+// ParseCommand returns the command the text or the caption of message starts with,
+// as marked by a bot_command entity, or nil if it doesn't start with a command.
+func ParseCommand(message *Message) *Command {
+	if message == nil {
+		return nil
+	}
+	text, entities := message.Text, message.Entities
+	if text == "" {
+		text, entities = message.Caption, message.CaptionEntities
+	}
+	for _, entity := range entities {
+		if entity.Type != "bot_command" || entity.Offset != 0 {
+			continue
+		}
+		// Entity offsets and lengths are measured in UTF-16 code units
+		units := utf16.Encode([]rune(text))
+		if entity.Length < 2 || entity.Length > len(units) {
+			return nil
+		}
+		command := string(utf16.Decode(units[1:entity.Length]))
+		rest := string(utf16.Decode(units[entity.Length:]))
+
+		name, mention, _ := strings.Cut(command, "@")
+		return &Command{Name: name, Mention: mention, Args: strings.TrimSpace(rest)}
+	}
+	return nil
+}
+
+// For reports whether the command is addressed to the bot with the given username:
+// it either mentions the bot or doesn't mention any bot
+func (command *Command) For(username string) bool {
+	return command.Mention == "" || strings.EqualFold(command.Mention, username)
+}
+
+// Quotes which may enclose an argument, mapped to the matching closing quotes.
+// Telegram clients often replace straight quotes with typographic ones
+var argumentQuotes = map[rune]rune{
+	'"':  '"',
+	'\'': '\'',
+	'“':  '”',
+	'«':  '»',
+}
+
+// Arguments splits Args into arguments separated by spaces. An argument containing spaces
+// can be enclosed in quotes; inside quotes, a backslash escapes the next character.
+// A quote inside a word, like the apostrophe in “don't”, is taken literally.
+func (command *Command) Arguments() ([]string, error) {
+	args, err := splitArguments(command.Args)
+	if err != nil {
+		return nil, err
+	}
+	var values []string
+	for _, arg := range args {
+		values = append(values, arg.value)
+	}
+	return values, nil
+}
+
+// An argument of a command together with the offset in bytes it starts at in Command.Args
+type argument struct {
+	value string
+	start int
+}
+
+// Splits s into arguments, see Command.Arguments
+func splitArguments(s string) ([]argument, error) {
+	var args []argument
+	var current strings.Builder
+	inArgument := false
+	start := 0
+	var closing rune
+	escaped := false
+
+	for i, r := range s {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case closing != 0:
+			switch r {
+			case '\\':
+				escaped = true
+			case closing:
+				closing = 0
+			default:
+				current.WriteRune(r)
+			}
+		case unicode.IsSpace(r):
+			if inArgument {
+				args = append(args, argument{value: current.String(), start: start})
+				current.Reset()
+				inArgument = false
+			}
+		case !inArgument:
+			inArgument = true
+			start = i
+			if quote, ok := argumentQuotes[r]; ok {
+				closing = quote
+			} else {
+				current.WriteRune(r)
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if closing != 0 || escaped {
+		return nil, fmt.Errorf("%w: unterminated quote", ErrInvalidArguments)
+	}
+	if inArgument {
+		args = append(args, argument{value: current.String(), start: start})
+	}
+	return args, nil
+}
+
+// Bind parses the arguments into the fields of the struct pointed to by v which have an arg tag,
+// in the order the fields are declared. The tag holds the name of the argument used in errors,
+// optionally followed by options separated by commas:
+//
+//	optional  the argument may be missing
+//	rest      the field receives the rest of Args as written, starting with its argument; it must be a string
+//
+// For example, “/ban @user 1h spam” can be bound to
+//
+//	type BanArgs struct {
+//		User     string        `arg:"user"`
+//		Duration time.Duration `arg:"duration,optional"`
+//		Reason   string        `arg:"reason,rest,optional"`
+//	}
+//
+// Fields can be strings, booleans, integers, floats, time.Duration or implement encoding.TextUnmarshaler.
+// Extra arguments are an error unless a field has the rest option.
+func (command *Command) Bind(v any) error {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Pointer || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("gogram: Bind needs a pointer to a struct, got %T", v)
+	}
+	args, err := splitArguments(command.Args)
+	if err != nil {
+		return err
+	}
+
+	structValue := value.Elem()
+	structType := structValue.Type()
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tag, ok := field.Tag.Lookup("arg")
+		if !ok || !field.IsExported() {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name
+		}
+		optional, rest := false, false
+		for _, option := range strings.Split(options, ",") {
+			switch option {
+			case "optional":
+				optional = true
+			case "rest":
+				rest = true
+			}
+		}
+
+		if len(args) == 0 {
+			if optional {
+				continue
+			}
+			return fmt.Errorf("%w: missing %s", ErrInvalidArguments, name)
+		}
+		arg := args[0].value
+		if rest {
+			// Keep the quotes and spacing of the free text
+			arg = command.Args[args[0].start:]
+			args = nil
+		} else {
+			args = args[1:]
+		}
+		if err := setArgument(structValue.Field(i), arg); err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrInvalidArguments, name, err)
+		}
+	}
+	if len(args) > 0 {
+		return fmt.Errorf("%w: unexpected %q", ErrInvalidArguments, args[0].value)
+	}
+	return nil
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Parses arg into field according to its type
+func setArgument(field reflect.Value, arg string) error {
+	if unmarshaler, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(arg))
+	}
+	if field.Type() == durationType {
+		duration, err := time.ParseDuration(arg)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q", arg)
+		}
+		field.SetInt(int64(duration))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(arg)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(arg)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", arg)
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(arg, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", arg)
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(arg, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid number %q", arg)
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(arg, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid number %q", arg)
+		}
+		field.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
+
+// Key under which CommandFilter stores the parsed command in Context
+const CommandKey = "command"
+
+// CommandFilter returns a filter which passes messages starting with one of the commands with
+// the given names, or with any command if no names are given. Commands addressed to other bots,
+// like “/start@OtherBot”, don't pass; if the bot's username is unknown because Bot.Self is nil,
+// only commands without a mention pass. The parsed command is stored in Context under CommandKey.
+func CommandFilter(names ...string) Filter {
+	return func(ctx *Context) bool {
+		command := ParseCommand(ctx.Message())
+		if command == nil {
+			return false
+		}
+		username := ""
+		if ctx.Bot != nil && ctx.Bot.Self != nil {
+			username = ctx.Bot.Self.Username
+		}
+		if command.Mention != "" && (username == "" || !command.For(username)) {
+			return false
+		}
+		if len(names) > 0 && !containsFold(names, command.Name) {
+			return false
+		}
+		ctx.Set(CommandKey, command)
+		return true
+	}
+}
+
+func containsFold(names []string, name string) bool {
+	for _, candidate := range names {
+		if strings.EqualFold(candidate, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Command returns the command stored by CommandFilter, or nil if there is none
+func (ctx *Context) Command() *Command {
+	value, _ := ctx.Get(CommandKey)
+	command, _ := value.(*Command)
+	return command
+}
+
+// OnCommand registers handler for new messages starting with the command with the given name
+// and passing all of filters. The handler can get the command with Context.Command.
+func (group *HandlerGroup) OnCommand(name string, handler HandlerFunc, filters ...Filter) {
+	group.OnMessage(handler, append([]Filter{CommandFilter(name)}, filters...)...)
+}
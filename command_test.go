@@ -0,0 +1,199 @@
+package gogram
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+	"unicode/utf16"
+)
+
+// Returns a message with text starting with a bot_command entity of the given length in UTF-16 code units
+func commandMessage(text string, length int) *Message {
+	return &Message{Text: text, Entities: []MessageEntity{{Type: "bot_command", Offset: 0, Length: length}}}
+}
+
+// Returns the length of s in UTF-16 code units
+func utf16Length(s string) int {
+	return len(utf16.Encode([]rune(s)))
+}
+
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		name    string
+		message *Message
+		want    *Command
+	}{
+		{"nil message", nil, nil},
+		{"plain text", &Message{Text: "hello"}, nil},
+		{"command", commandMessage("/start", 6), &Command{Name: "start"}},
+		{"arguments", commandMessage("/ban  @user 1h spam ", 4), &Command{Name: "ban", Args: "@user 1h spam"}},
+		{"mention", commandMessage("/ban@MyBot @user", 10), &Command{Name: "ban", Mention: "MyBot", Args: "@user"}},
+		{
+			// The emoji takes two UTF-16 code units, so byte and rune offsets would cut the text elsewhere
+			"UTF-16 offsets",
+			commandMessage("/say😀 привет", utf16Length("/say😀")),
+			&Command{Name: "say😀", Args: "привет"},
+		},
+		{
+			"caption",
+			&Message{Caption: "/start now", CaptionEntities: []MessageEntity{{Type: "bot_command", Length: 6}}},
+			&Command{Name: "start", Args: "now"},
+		},
+		{
+			"command not at the start",
+			&Message{Text: "hi /start", Entities: []MessageEntity{{Type: "bot_command", Offset: 3, Length: 6}}},
+			nil,
+		},
+		{"entity longer than text", commandMessage("/a", 5), nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := ParseCommand(test.message)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("ParseCommand() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCommandFilter(t *testing.T) {
+	bot := &Bot{Self: &User{Username: "MyBot"}}
+	tests := []struct {
+		name  string
+		bot   *Bot
+		text  string
+		names []string
+		want  bool
+	}{
+		{"without mention", bot, "/start", []string{"start"}, true},
+		{"mentioning the bot", bot, "/start@MyBot", []string{"start"}, true},
+		{"mention in other case", bot, "/start@mybot", []string{"start"}, true},
+		{"mentioning another bot", bot, "/start@OtherBot", []string{"start"}, false},
+		{"mention with unknown username", &Bot{}, "/start@MyBot", []string{"start"}, false},
+		{"other command", bot, "/help", []string{"start"}, false},
+		{"any command", bot, "/help", nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := NewContext(context.Background(), test.bot, &Update{
+				Message: commandMessage(test.text, utf16Length(test.text)),
+			})
+			if got := CommandFilter(test.names...)(ctx); got != test.want {
+				t.Fatalf("CommandFilter() = %v, want %v", got, test.want)
+			}
+			if test.want && ctx.Command() == nil {
+				t.Error("command not stored in Context")
+			}
+		})
+	}
+}
+
+func TestCommandArguments(t *testing.T) {
+	tests := []struct {
+		args    string
+		want    []string
+		wantErr bool
+	}{
+		{"", nil, false},
+		{"@user 1h spam", []string{"@user", "1h", "spam"}, false},
+		{"a  \t b", []string{"a", "b"}, false},
+		{`"two words" one`, []string{"two words", "one"}, false},
+		{`“typographic quotes” «guillemets»`, []string{"typographic quotes", "guillemets"}, false},
+		{`'single quotes'`, []string{"single quotes"}, false},
+		{`"escaped \" quote"`, []string{`escaped " quote`}, false},
+		{`"" empty`, []string{"", "empty"}, false},
+		{"@user 1h don't spam", []string{"@user", "1h", "don't", "spam"}, false},
+		{`a"b c`, []string{`a"b`, "c"}, false},
+		{`"unterminated`, nil, true},
+		{`"escape at the end \`, nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.args, func(t *testing.T) {
+			command := &Command{Name: "test", Args: test.args}
+			got, err := command.Arguments()
+			if test.wantErr {
+				if !errors.Is(err, ErrInvalidArguments) {
+					t.Fatalf("Arguments() error = %v, want ErrInvalidArguments", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Arguments() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Arguments() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+type banArgs struct {
+	User     string        `arg:"user"`
+	Duration time.Duration `arg:"duration,optional"`
+	Reason   string        `arg:"reason,rest,optional"`
+}
+
+type countArgs struct {
+	Count  int     `arg:"count"`
+	Ratio  float64 `arg:"ratio,optional"`
+	Silent bool    `arg:"silent,optional"`
+	hidden string
+}
+
+func TestCommandBind(t *testing.T) {
+	tests := []struct {
+		args    string
+		value   any
+		want    any
+		wantErr bool
+	}{
+		{"@user", &banArgs{}, &banArgs{User: "@user"}, false},
+		{"@user 1h", &banArgs{}, &banArgs{User: "@user", Duration: time.Hour}, false},
+		{
+			"@user 1h don't  spam",
+			&banArgs{},
+			&banArgs{User: "@user", Duration: time.Hour, Reason: "don't  spam"},
+			false,
+		},
+		{
+			`"@user" 30m "quoted"   reason`,
+			&banArgs{},
+			&banArgs{User: "@user", Duration: 30 * time.Minute, Reason: `"quoted"   reason`},
+			false,
+		},
+		{"", &banArgs{}, nil, true},
+		{"@user forever", &banArgs{}, nil, true},
+		{"3 0.5 true", &countArgs{}, &countArgs{Count: 3, Ratio: 0.5, Silent: true}, false},
+		{"3", &countArgs{}, &countArgs{Count: 3}, false},
+		{"three", &countArgs{}, nil, true},
+		{"3 0.5 true extra", &countArgs{}, nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.args, func(t *testing.T) {
+			command := &Command{Name: "test", Args: test.args}
+			err := command.Bind(test.value)
+			if test.wantErr {
+				if !errors.Is(err, ErrInvalidArguments) {
+					t.Fatalf("Bind() error = %v, want ErrInvalidArguments", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Bind() error = %v", err)
+			}
+			if !reflect.DeepEqual(test.value, test.want) {
+				t.Errorf("Bind() = %+v, want %+v", test.value, test.want)
+			}
+		})
+	}
+}
+
+func TestCommandBindNotStruct(t *testing.T) {
+	var s string
+	command := &Command{Name: "test", Args: "a"}
+	if err := command.Bind(&s); err == nil {
+		t.Error("Bind() to a string pointer succeeded")
+	}
+}
@@ -0,0 +1,135 @@
+package gogram
+
+import "context"
+
+// Context is passed to the handlers of an update. It carries the context.Context the update
+// is handled in, the bot that received it and values stored by filters and earlier handlers.
+type Context struct {
+	context.Context
+
+	// The bot that received the update
+	Bot *Bot
+
+	// The update being handled
+	Update *Update
+
+	values map[string]any
+}
+
+// Creates new context for handling update received by bot
+func NewContext(ctx context.Context, bot *Bot, update *Update) *Context {
+	return &Context{Context: ctx, Bot: bot, Update: update}
+}
+
+// Set stores value under key for the handlers called after the current one
+func (ctx *Context) Set(key string, value any) {
+	if ctx.values == nil {
+		ctx.values = make(map[string]any)
+	}
+	ctx.values[key] = value
+}
+
+// Get returns the value stored under key
+func (ctx *Context) Get(key string) (any, bool) {
+	value, ok := ctx.values[key]
+	return value, ok
+}
+
+// Message returns the message the update is about: the new or edited message or channel post,
+// or the message with the callback button that was pressed. It returns nil for other updates.
+func (ctx *Context) Message() *Message {
+	update := ctx.Update
+	switch {
+	case update.Message != nil:
+		return update.Message
+	case update.EditedMessage != nil:
+		return update.EditedMessage
+	case update.ChannelPost != nil:
+		return update.ChannelPost
+	case update.EditedChannelPost != nil:
+		return update.EditedChannelPost
+	case update.CallbackQuery != nil:
+		return update.CallbackQuery.Message
+	}
+	return nil
+}
+
+// Chat returns the chat the update comes from, or nil if it doesn't come from a chat
+func (ctx *Context) Chat() *Chat {
+	update := ctx.Update
+	switch {
+	case update.MyChatMember != nil:
+		return update.MyChatMember.Chat
+	case update.ChatMember != nil:
+		return update.ChatMember.Chat
+	case update.ChatJoinRequest != nil:
+		return update.ChatJoinRequest.Chat
+	}
+	if message := ctx.Message(); message != nil {
+		return message.Chat
+	}
+	return nil
+}
+
+// Sender returns the user who caused the update, or nil if it wasn't caused by a user,
+// as with channel posts and polls
+func (ctx *Context) Sender() *User {
+	update := ctx.Update
+	switch {
+	case update.Message != nil:
+		return update.Message.From
+	case update.EditedMessage != nil:
+		return update.EditedMessage.From
+	case update.ChannelPost != nil:
+		return update.ChannelPost.From
+	case update.EditedChannelPost != nil:
+		return update.EditedChannelPost.From
+	case update.InlineQuery != nil:
+		return update.InlineQuery.From
+	case update.ChosenInlineResult != nil:
+		return update.ChosenInlineResult.From
+	case update.CallbackQuery != nil:
+		return update.CallbackQuery.From
+	case update.ShippingQuery != nil:
+		return update.ShippingQuery.From
+	case update.PreCheckoutQuery != nil:
+		return update.PreCheckoutQuery.From
+	case update.PollAnswer != nil:
+		return update.PollAnswer.User
+	case update.MyChatMember != nil:
+		return update.MyChatMember.From
+	case update.ChatMember != nil:
+		return update.ChatMember.From
+	case update.ChatJoinRequest != nil:
+		return update.ChatJoinRequest.From
+	}
+	return nil
+}
+
+// Reply sends text to the chat of the update as a reply to the message the update is about,
+// in the same forum topic. The text is still sent if that message was deleted, and is sent
+// as a plain message for updates not about a message. It returns an error if the update doesn't come from a chat.
+func (ctx *Context) Reply(text string) (*Message, error) {
+	params := &SendMessageParams{Text: text}
+	if message := ctx.Message(); message != nil {
+		params.ReplyToMessageId = message.MessageId
+		params.AllowSendingWithoutReply = true
+		if message.IsTopicMessage {
+			params.MessageThreadId = message.MessageThreadId
+		}
+	}
+	return ctx.Send(params)
+}
+
+// Send sends the message to the chat of the update, unless params.ChatId is set.
+// It returns an error if ChatId is not set and the update doesn't come from a chat.
+func (ctx *Context) Send(params *SendMessageParams) (*Message, error) {
+	if params.ChatId == nil {
+		chat := ctx.Chat()
+		if chat == nil {
+			return nil, ErrNoChat
+		}
+		params.ChatId = chat.Id
+	}
+	return ctx.Bot.SendMessage(ctx, params)
+}
@@ -1,15 +1,36 @@
-package main
+package gogram
+
+import "encoding/json"
 
 type Response struct {
 	// True, if bot or user has been founded
 	Ok bool `json:"ok"`
 
-	// struct User
-	Result map[string]interface{} `json:"result"`
+	// Result of the request. Its type depends on the called method
+	Result json.RawMessage `json:"result"`
 
 	// Error code
 	ErrorCode int `json:"error_code"`
 
 	// Error code description
 	Description string `json:"description"`
+
+	// Optional. Information about why the request was unsuccessful
+	Parameters *ResponseParameters `json:"parameters"`
+}
+
+// Returns nil if the request succeeded, *APIError otherwise
+func (response *Response) error(method string) error {
+	if response.Ok {
+		return nil
+	}
+	err := &APIError{
+		Method:      method,
+		ErrorCode:   response.ErrorCode,
+		Description: response.Description,
+	}
+	if response.Parameters != nil {
+		err.Parameters = *response.Parameters
+	}
+	return err
 }
@@ -0,0 +1,82 @@
+package gogram
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Sentinel errors used to classify an *APIError with errors.Is
+var (
+	// The bot token is invalid or the bot was logged out
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// The user has blocked the bot
+	ErrBotBlocked = errors.New("bot was blocked by the user")
+
+	// The bot was removed from the group or channel
+	ErrBotKicked = errors.New("bot was kicked")
+
+	// The user account has been deleted
+	ErrUserDeactivated = errors.New("user is deactivated")
+
+	// The chat doesn't exist or the bot has no access to it
+	ErrChatNotFound = errors.New("chat not found")
+
+	// The user doesn't exist or the bot has never seen them
+	ErrUserNotFound = errors.New("user not found")
+
+	// The edited message would be exactly the same as the current one
+	ErrMessageNotModified = errors.New("message is not modified")
+
+	// Flood control was exceeded, see APIError.RetryAfter
+	ErrTooManyRequests = errors.New("too many requests")
+
+	// The group has been migrated to a supergroup, see APIError.Parameters.MigrateToChatId
+	ErrChatMigrated = errors.New("group chat was upgraded to a supergroup chat")
+)
+
+// APIError is returned when the Bot API answers a request with "ok": false
+type APIError struct {
+	// Name of the called method
+	Method string
+
+	// Error code
+	ErrorCode int
+
+	// Error code description
+	Description string
+
+	// Optional. Information about why the request was unsuccessful
+	Parameters ResponseParameters
+}
+
+func (err *APIError) Error() string {
+	return fmt.Sprintf("method %s finished with error_code: %d, description: %s", err.Method, err.ErrorCode, err.Description)
+}
+
+// RetryAfter returns how long to wait before the request can be repeated
+// after exceeding flood control, or 0 if the error is not caused by flood control
+func (err *APIError) RetryAfter() time.Duration {
+	return time.Duration(err.Parameters.RetryAfter) * time.Second
+}
+
+// Is reports whether the error matches one of the sentinel errors of this package
+func (err *APIError) Is(target error) bool {
+	description := strings.ToLower(err.Description)
+	switch target {
+	case ErrUnauthorized:
+		return err.ErrorCode == http.StatusUnauthorized
+	case ErrBotBlocked, ErrBotKicked, ErrUserDeactivated:
+		return err.ErrorCode == http.StatusForbidden && strings.Contains(description, target.Error())
+	case ErrChatNotFound, ErrUserNotFound, ErrMessageNotModified:
+		return err.ErrorCode == http.StatusBadRequest && strings.Contains(description, target.Error())
+	case ErrTooManyRequests:
+		return err.ErrorCode == http.StatusTooManyRequests || err.Parameters.RetryAfter != 0
+	case ErrChatMigrated:
+		return err.Parameters.MigrateToChatId != 0
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package gogram
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// Use this method to get basic information about a file and prepare it for downloading.
+// For the moment, bots can download files of up to 20MB in size. On success, a File object is returned.
+// The file can then be downloaded with DownloadFile. It is guaranteed that the link
+// will be valid for at least 1 hour. When the link expires, a new one can be requested by calling getFile again.
+func (bot *Bot) GetFile(ctx context.Context, fileId string) (*File, error) {
+	return Call[*File](ctx, bot, "getFile", &GetFileParams{FileId: fileId})
+}
+
+// DownloadFile writes the contents of file, as returned by GetFile, to w.
+// When a local Bot API server is used, FilePath is an absolute path on the local disk
+// and the file is read from there directly.
+func (bot *Bot) DownloadFile(ctx context.Context, file *File, w io.Writer) error {
+	if file.FilePath == "" {
+		return fmt.Errorf("file %s has no file_path, it must be requested with GetFile", file.FileId)
+	}
+	if filepath.IsAbs(file.FilePath) {
+		return copyLocalFile(file.FilePath, w)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, bot.fileURL(file.FilePath), nil)
+	if err != nil {
+		return bot.redactError(fmt.Errorf("downloading file %s: %w", file.FileId, err))
+	}
+	response, err := bot.client().Do(req)
+	if err != nil {
+		return bot.redactError(fmt.Errorf("downloading file %s: %w", file.FileId, err))
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("downloading file %s: unexpected HTTP status: %s", file.FileId, response.Status)
+	}
+	if _, err := io.Copy(w, response.Body); err != nil {
+		return bot.redactError(fmt.Errorf("downloading file %s: %w", file.FileId, err))
+	}
+	return nil
+}
+
+// DownloadFileById gets the file with GetFile and writes its contents to w
+func (bot *Bot) DownloadFileById(ctx context.Context, fileId string, w io.Writer) error {
+	file, err := bot.GetFile(ctx, fileId)
+	if err != nil {
+		return err
+	}
+	return bot.DownloadFile(ctx, file, w)
+}
+
+func copyLocalFile(path string, w io.Writer) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// Download writes the contents of the photo to w
+func (photo *PhotoSize) Download(ctx context.Context, bot *Bot, w io.Writer) error {
+	return bot.DownloadFileById(ctx, photo.FileId, w)
+}
+
+// Download writes the contents of the animation to w
+func (animation *Animation) Download(ctx context.Context, bot *Bot, w io.Writer) error {
+	return bot.DownloadFileById(ctx, animation.FileId, w)
+}
+
+// Download writes the contents of the audio file to w
+func (audio *Audio) Download(ctx context.Context, bot *Bot, w io.Writer) error {
+	return bot.DownloadFileById(ctx, audio.FileId, w)
+}
+
+// Download writes the contents of the document to w
+func (document *Document) Download(ctx context.Context, bot *Bot, w io.Writer) error {
+	return bot.DownloadFileById(ctx, document.FileId, w)
+}
+
+// Download writes the contents of the video to w
+func (video *Video) Download(ctx context.Context, bot *Bot, w io.Writer) error {
+	return bot.DownloadFileById(ctx, video.FileId, w)
+}
+
+// Download writes the contents of the video message to w
+func (videoNote *VideoNote) Download(ctx context.Context, bot *Bot, w io.Writer) error {
+	return bot.DownloadFileById(ctx, videoNote.FileId, w)
+}
+
+// Download writes the contents of the voice message to w
+func (voice *Voice) Download(ctx context.Context, bot *Bot, w io.Writer) error {
+	return bot.DownloadFileById(ctx, voice.FileId, w)
+}
+
+// Download writes the contents of the sticker to w
+func (sticker *Sticker) Download(ctx context.Context, bot *Bot, w io.Writer) error {
+	return bot.DownloadFileById(ctx, sticker.FileId, w)
+}
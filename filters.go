@@ -0,0 +1,156 @@
+package gogram
+
+import "regexp"
+
+// Type of chat
+const (
+	ChatTypePrivate    = "private"
+	ChatTypeGroup      = "group"
+	ChatTypeSupergroup = "supergroup"
+	ChatTypeChannel    = "channel"
+)
+
+// Filter reports whether a handler should handle the update in ctx.
+// Filters may store values in ctx for the handler, like the submatches found by Text.
+type Filter func(ctx *Context) bool
+
+// And returns a filter which passes updates passing all of filters
+func And(filters ...Filter) Filter {
+	return func(ctx *Context) bool {
+		for _, filter := range filters {
+			if filter != nil && !filter(ctx) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or returns a filter which passes updates passing any of filters
+func Or(filters ...Filter) Filter {
+	return func(ctx *Context) bool {
+		for _, filter := range filters {
+			if filter != nil && filter(ctx) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not returns a filter which passes updates not passing filter
+func Not(filter Filter) Filter {
+	return func(ctx *Context) bool {
+		return !filter(ctx)
+	}
+}
+
+// Key under which Text stores the submatches of its pattern in Context
+const TextMatchKey = "text_match"
+
+// Text returns a filter which passes updates whose text matches the regular expression pattern.
+// The text is the data of a callback query, the query of an inline query, or the text or caption
+// of a message. The submatches are stored in Context under TextMatchKey as []string;
+// in a handler group they are only kept if all filters of the handler pass.
+// Text panics if pattern can't be compiled.
+func Text(pattern string) Filter {
+	re := regexp.MustCompile(pattern)
+	return func(ctx *Context) bool {
+		text, ok := updateText(ctx)
+		if !ok {
+			return false
+		}
+		match := re.FindStringSubmatch(text)
+		if match == nil {
+			return false
+		}
+		ctx.Set(TextMatchKey, match)
+		return true
+	}
+}
+
+// Returns the text of the update Text is matched against
+func updateText(ctx *Context) (string, bool) {
+	update := ctx.Update
+	switch {
+	case update.CallbackQuery != nil:
+		return update.CallbackQuery.Data, true
+	case update.InlineQuery != nil:
+		return update.InlineQuery.Query, true
+	}
+	message := ctx.Message()
+	if message == nil {
+		return "", false
+	}
+	if message.Text != "" {
+		return message.Text, true
+	}
+	return message.Caption, true
+}
+
+// ChatType returns a filter which passes updates from chats of the given types,
+// see the ChatType constants
+func ChatType(types ...string) Filter {
+	return func(ctx *Context) bool {
+		chat := ctx.Chat()
+		if chat == nil {
+			return false
+		}
+		for _, chatType := range types {
+			if chat.Type == chatType {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// HasMedia returns a filter which passes messages with a photo, video, animation, audio,
+// document, voice message, video note or sticker
+func HasMedia() Filter {
+	return func(ctx *Context) bool {
+		message := ctx.Message()
+		if message == nil {
+			return false
+		}
+		return len(message.Photo) > 0 || message.Video != nil || message.Animation != nil ||
+			message.Audio != nil || message.Document != nil || message.Voice != nil ||
+			message.VideoNote != nil || message.Sticker != nil
+	}
+}
+
+// FromUser returns a filter which passes updates caused by one of the users with the given ids
+func FromUser(ids ...int64) Filter {
+	return func(ctx *Context) bool {
+		sender := ctx.Sender()
+		if sender == nil {
+			return false
+		}
+		for _, id := range ids {
+			if sender.Id == id {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// InThread returns a filter which passes messages sent to one of the forum topics with the given
+// message thread ids. Without ids, it passes messages sent to any forum topic.
+func InThread(ids ...int) Filter {
+	return func(ctx *Context) bool {
+		message := ctx.Message()
+		if message == nil || !message.IsTopicMessage {
+			return false
+		}
+		if len(ids) == 0 {
+			return true
+		}
+		for _, id := range ids {
+			if message.MessageThreadId == id {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,255 @@
+package gogram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// FSM runs multi-step conversations, like sign-up forms, as finite state machines.
+// Every conversation is kept per user, chat and forum topic in a Storage and has a state,
+// which selects the handler for the next update of the conversation, and data stored by the handlers.
+//
+// FSM is used as a router middleware: router.Use(fsm.Middleware()). A conversation is started
+// by any handler setting a state with ctx.Session().SetState, and continues in the handlers
+// registered for its states with Handle. Updates of a conversation in a state without a handler
+// are passed on to the router, where they can be matched with the InState filter.
+//
+// While a conversation is in a state with a handler, its updates don't reach the middlewares
+// added after the FSM or the handler groups of the router, so a global command like “/help”
+// is not handled. A state handler can return ErrContinue to pass the update on to them.
+type FSM struct {
+	// Storage the conversations are kept in
+	Storage Storage
+
+	// How long a conversation may wait for the next update. When an update arrives for a conversation
+	// idle for longer, the conversation is finished, OnTimeout is called and the update is handled
+	// as if there were no conversation. Zero means conversations never time out.
+	//
+	// The timeout is only checked when an update arrives, so the records of abandoned conversations
+	// stay in the Storage until Expire is called
+	Timeout time.Duration
+
+	// Commands which finish a conversation in progress, like “cancel”
+	CancelCommands []string
+
+	// Optional. Called after a conversation is finished by a cancel command, to tell the user about it
+	OnCancel HandlerFunc
+
+	// Optional. Called after a conversation is finished because of Timeout, before the update is handled
+	OnTimeout HandlerFunc
+
+	handlers map[string]HandlerFunc
+}
+
+// Creates new state machine keeping the conversations in storage
+func NewFSM(storage Storage) *FSM {
+	return &FSM{Storage: storage}
+}
+
+// Handle registers handler for the updates of conversations in state.
+// Handlers must be registered before the middleware is used.
+func (fsm *FSM) Handle(state string, handler HandlerFunc) {
+	if fsm.handlers == nil {
+		fsm.handlers = make(map[string]HandlerFunc)
+	}
+	fsm.handlers[state] = handler
+}
+
+// Key under which the FSM middleware stores the *Session in Context
+const SessionKey = "session"
+
+// Middleware returns the middleware that loads the conversation of every update into Context,
+// handles cancel commands, timeouts and the registered states, and saves the conversation
+// after the update is handled, unless a handler returned an error.
+func (fsm *FSM) Middleware() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx *Context) error {
+			key, ok := stateKey(ctx)
+			if !ok {
+				return next(ctx)
+			}
+			record, err := fsm.Storage.Get(ctx, key)
+			if err != nil {
+				return err
+			}
+			session := &Session{Key: key}
+			if record != nil {
+				session.State = record.State
+				session.data = record.Data
+				session.updatedAt = record.UpdatedAt
+			}
+			ctx.Set(SessionKey, session)
+
+			if session.State != "" && fsm.Timeout > 0 && time.Since(session.updatedAt) > fsm.Timeout {
+				session.Finish()
+				if err := fsm.save(ctx, session); err != nil {
+					return err
+				}
+				if fsm.OnTimeout != nil {
+					if err := fsm.OnTimeout(ctx); err != nil {
+						return err
+					}
+				}
+			}
+
+			if session.State != "" && fsm.isCancelCommand(ctx) {
+				session.Finish()
+				if err := fsm.save(ctx, session); err != nil {
+					return err
+				}
+				if fsm.OnCancel != nil {
+					return fsm.OnCancel(ctx)
+				}
+				return nil
+			}
+
+			handler, inState := fsm.handlers[session.State]
+			if session.State == "" || !inState {
+				inState = false
+				handler = next
+			} else {
+				// The update belongs to the conversation, so it counts as activity even if nothing changed
+				session.changed = true
+			}
+			err = handler(ctx)
+			if inState && errors.Is(err, ErrContinue) {
+				err = next(ctx)
+			}
+			if err != nil && !errors.Is(err, ErrStop) && !errors.Is(err, ErrContinue) {
+				return err
+			}
+			if saveErr := fsm.save(ctx, session); saveErr != nil {
+				return saveErr
+			}
+			return err
+		}
+	}
+}
+
+// Expire removes the records of the conversations idle for longer than Timeout from the Storage
+// and returns their keys. OnTimeout is not called for them, as there is no update to pass to it;
+// the keys can be used to tell the users instead. Call it periodically, for example
+// from a time.Ticker loop, to keep abandoned conversations from piling up. It does nothing if Timeout is zero.
+func (fsm *FSM) Expire(ctx context.Context) ([]StateKey, error) {
+	if fsm.Timeout <= 0 {
+		return nil, nil
+	}
+	return fsm.Storage.DeleteExpired(ctx, time.Now().Add(-fsm.Timeout))
+}
+
+// Saves the session if it was changed
+func (fsm *FSM) save(ctx *Context, session *Session) error {
+	if !session.changed {
+		return nil
+	}
+	session.changed = false
+	if session.State == "" && len(session.data) == 0 {
+		return fsm.Storage.Delete(ctx, session.Key)
+	}
+	session.updatedAt = time.Now()
+	return fsm.Storage.Set(ctx, session.Key, &StateRecord{
+		State:     session.State,
+		Data:      session.data,
+		UpdatedAt: session.updatedAt,
+	})
+}
+
+// Reports whether the update is a message with one of the cancel commands addressed to the bot
+func (fsm *FSM) isCancelCommand(ctx *Context) bool {
+	if len(fsm.CancelCommands) == 0 || ctx.Update.Message == nil {
+		return false
+	}
+	return CommandFilter(fsm.CancelCommands...)(ctx)
+}
+
+// Returns the key of the conversation the update belongs to
+func stateKey(ctx *Context) (StateKey, bool) {
+	var key StateKey
+	if chat := ctx.Chat(); chat != nil {
+		key.ChatId = chat.Id
+	}
+	if sender := ctx.Sender(); sender != nil {
+		key.UserId = sender.Id
+	}
+	if message := ctx.Message(); message != nil && message.IsTopicMessage {
+		key.ThreadId = message.MessageThreadId
+	}
+	return key, key.ChatId != 0 || key.UserId != 0
+}
+
+// Session is the conversation an update belongs to. Changes made by the handlers
+// are saved after the update is handled.
+type Session struct {
+	// The conversation the session belongs to
+	Key StateKey
+
+	// Current state of the conversation, empty if there is no conversation in progress
+	State string
+
+	data      map[string]json.RawMessage
+	updatedAt time.Time
+	changed   bool
+}
+
+// SetState moves the conversation to state. An empty state finishes the conversation but keeps its data
+func (session *Session) SetState(state string) {
+	session.State = state
+	session.changed = true
+}
+
+// Finish ends the conversation and removes its data
+func (session *Session) Finish() {
+	session.State = ""
+	session.data = nil
+	session.changed = true
+}
+
+// Set stores value, encoded as JSON, under name in the conversation data
+func (session *Session) Set(name string, value any) error {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	if session.data == nil {
+		session.data = make(map[string]json.RawMessage)
+	}
+	session.data[name] = encoded
+	session.changed = true
+	return nil
+}
+
+// Get decodes the value stored under name in the conversation data into value.
+// It reports whether there is such a value.
+func (session *Session) Get(name string, value any) (bool, error) {
+	encoded, ok := session.data[name]
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal(encoded, value)
+}
+
+// Session returns the conversation the update belongs to, as loaded by the FSM middleware,
+// or nil if there is none
+func (ctx *Context) Session() *Session {
+	value, _ := ctx.Get(SessionKey)
+	session, _ := value.(*Session)
+	return session
+}
+
+// InState returns a filter which passes the updates of conversations in one of the given states
+func InState(states ...string) Filter {
+	return func(ctx *Context) bool {
+		session := ctx.Session()
+		if session == nil {
+			return false
+		}
+		for _, state := range states {
+			if session.State == state {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,262 @@
+package gogram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// StateKey identifies a conversation: a user in a chat, in a forum topic of the chat if ThreadId is not 0
+type StateKey struct {
+	ChatId   int64
+	UserId   int64
+	ThreadId int
+}
+
+func (key StateKey) String() string {
+	return fmt.Sprintf("%d:%d:%d", key.ChatId, key.UserId, key.ThreadId)
+}
+
+// Parses a key formatted by StateKey.String
+func parseStateKey(s string) (StateKey, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return StateKey{}, fmt.Errorf("invalid state key %q", s)
+	}
+	chatId, err1 := strconv.ParseInt(parts[0], 10, 64)
+	userId, err2 := strconv.ParseInt(parts[1], 10, 64)
+	threadId, err3 := strconv.Atoi(parts[2])
+	if err := errors.Join(err1, err2, err3); err != nil {
+		return StateKey{}, fmt.Errorf("invalid state key %q: %w", s, err)
+	}
+	return StateKey{ChatId: chatId, UserId: userId, ThreadId: threadId}, nil
+}
+
+// StateRecord is the state of a conversation as kept in a Storage
+type StateRecord struct {
+	// Current state of the conversation
+	State string `json:"state"`
+
+	// Values stored by the handlers, encoded as JSON
+	Data map[string]json.RawMessage `json:"data,omitempty"`
+
+	// Time the record was last saved
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Returns a copy of the record which doesn't share Data with it
+func (record *StateRecord) clone() *StateRecord {
+	clone := *record
+	if record.Data != nil {
+		clone.Data = make(map[string]json.RawMessage, len(record.Data))
+		for name, value := range record.Data {
+			clone.Data[name] = value
+		}
+	}
+	return &clone
+}
+
+// Storage keeps the state of conversations for FSM. Its methods may be called concurrently.
+type Storage interface {
+	// Get returns the record of the conversation, or nil if there is none
+	Get(ctx context.Context, key StateKey) (*StateRecord, error)
+
+	// Set replaces the record of the conversation
+	Set(ctx context.Context, key StateKey, record *StateRecord) error
+
+	// Delete removes the record of the conversation, if there is one
+	Delete(ctx context.Context, key StateKey) error
+
+	// DeleteExpired removes the records saved before the given time and returns their keys
+	DeleteExpired(ctx context.Context, before time.Time) ([]StateKey, error)
+}
+
+// MemoryStorage is a Storage which keeps the records in memory, so they are lost when the process exits
+type MemoryStorage struct {
+	mu      sync.Mutex
+	records map[StateKey]*StateRecord
+}
+
+// Creates new empty memory storage
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{records: make(map[StateKey]*StateRecord)}
+}
+
+func (storage *MemoryStorage) Get(ctx context.Context, key StateKey) (*StateRecord, error) {
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+	record, ok := storage.records[key]
+	if !ok {
+		return nil, nil
+	}
+	return record.clone(), nil
+}
+
+func (storage *MemoryStorage) Set(ctx context.Context, key StateKey, record *StateRecord) error {
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+	storage.records[key] = record.clone()
+	return nil
+}
+
+func (storage *MemoryStorage) Delete(ctx context.Context, key StateKey) error {
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+	delete(storage.records, key)
+	return nil
+}
+
+func (storage *MemoryStorage) DeleteExpired(ctx context.Context, before time.Time) ([]StateKey, error) {
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+	var keys []StateKey
+	for key, record := range storage.records {
+		if record.UpdatedAt.Before(before) {
+			delete(storage.records, key)
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// FileStorage is a Storage which keeps the records in memory and writes all of them to a JSON file
+// on every change, so they survive restarts. The file is replaced atomically, so it is never left
+// half-written. It suits bots with a moderate number of conversations in progress.
+type FileStorage struct {
+	path string
+
+	mu      sync.Mutex
+	records map[StateKey]*StateRecord
+}
+
+// Creates new file storage keeping the records in the file at path,
+// and loads the records from it if the file exists
+func NewFileStorage(path string) (*FileStorage, error) {
+	storage := &FileStorage{path: path, records: make(map[StateKey]*StateRecord)}
+	contents, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return storage, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var records map[string]*StateRecord
+	if err := json.Unmarshal(contents, &records); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", path, err)
+	}
+	for s, record := range records {
+		key, err := parseStateKey(s)
+		if err != nil {
+			return nil, fmt.Errorf("decoding %s: %w", path, err)
+		}
+		storage.records[key] = record
+	}
+	return storage, nil
+}
+
+func (storage *FileStorage) Get(ctx context.Context, key StateKey) (*StateRecord, error) {
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+	record, ok := storage.records[key]
+	if !ok {
+		return nil, nil
+	}
+	return record.clone(), nil
+}
+
+func (storage *FileStorage) Set(ctx context.Context, key StateKey, record *StateRecord) error {
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+	previous, existed := storage.records[key]
+	storage.records[key] = record.clone()
+	if err := storage.save(); err != nil {
+		// Keep the records in memory the same as in the file
+		if existed {
+			storage.records[key] = previous
+		} else {
+			delete(storage.records, key)
+		}
+		return err
+	}
+	return nil
+}
+
+func (storage *FileStorage) Delete(ctx context.Context, key StateKey) error {
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+	previous, existed := storage.records[key]
+	if !existed {
+		return nil
+	}
+	delete(storage.records, key)
+	if err := storage.save(); err != nil {
+		storage.records[key] = previous
+		return err
+	}
+	return nil
+}
+
+// DeleteExpired removes the expired records and writes the file once for all of them
+func (storage *FileStorage) DeleteExpired(ctx context.Context, before time.Time) ([]StateKey, error) {
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+	expired := make(map[StateKey]*StateRecord)
+	for key, record := range storage.records {
+		if record.UpdatedAt.Before(before) {
+			expired[key] = record
+			delete(storage.records, key)
+		}
+	}
+	if len(expired) == 0 {
+		return nil, nil
+	}
+	if err := storage.save(); err != nil {
+		for key, record := range expired {
+			storage.records[key] = record
+		}
+		return nil, err
+	}
+	keys := make([]StateKey, 0, len(expired))
+	for key := range expired {
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// Writes all records to a temporary file and renames it over the storage file
+func (storage *FileStorage) save() error {
+	records := make(map[string]*StateRecord, len(storage.records))
+	for key, record := range storage.records {
+		records[key.String()] = record
+	}
+	contents, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(storage.path), filepath.Base(storage.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(contents); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), storage.path)
+}
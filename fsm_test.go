@@ -0,0 +1,179 @@
+package gogram
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// Returns an update with a text message from user 2 in chat 1
+func textUpdate(text string) *Update {
+	return &Update{Message: &Message{
+		MessageId: 1,
+		From:      &User{Id: 2},
+		Chat:      &Chat{Id: 1, Type: "private"},
+		Text:      text,
+	}}
+}
+
+var testStateKey = StateKey{ChatId: 1, UserId: 2}
+
+func TestFSMConversation(t *testing.T) {
+	storage := NewMemoryStorage()
+	fsm := NewFSM(storage)
+	fsm.CancelCommands = []string{"cancel"}
+	var name string
+	fsm.Handle("name", func(ctx *Context) error {
+		ctx.Session().Set("name", ctx.Message().Text)
+		ctx.Session().SetState("city")
+		return nil
+	})
+	fsm.Handle("city", func(ctx *Context) error {
+		ctx.Session().Get("name", &name)
+		ctx.Session().Finish()
+		return nil
+	})
+
+	router := NewRouter(nil)
+	router.Use(fsm.Middleware())
+	router.OnMessage(func(ctx *Context) error {
+		ctx.Session().SetState("name")
+		return nil
+	}, Text("^signup$"))
+
+	for _, text := range []string{"signup", "Alice", "Paris"} {
+		if err := router.Dispatch(context.Background(), textUpdate(text)); err != nil {
+			t.Fatalf("handling %q: %v", text, err)
+		}
+	}
+	if name != "Alice" {
+		t.Errorf("got name %q, want Alice", name)
+	}
+	if record, _ := storage.Get(context.Background(), testStateKey); record != nil {
+		t.Errorf("finished conversation left record %+v", record)
+	}
+}
+
+func TestFSMStateHandlerContinue(t *testing.T) {
+	storage := NewMemoryStorage()
+	storage.Set(context.Background(), testStateKey, &StateRecord{State: "name", UpdatedAt: time.Now()})
+	fsm := NewFSM(storage)
+	fsm.Handle("name", func(ctx *Context) error {
+		if ctx.Message().Text == "help" {
+			return ErrContinue
+		}
+		return nil
+	})
+
+	router := NewRouter(nil)
+	router.Use(fsm.Middleware())
+	helped := false
+	router.OnMessage(func(ctx *Context) error {
+		helped = true
+		return nil
+	}, Text("^help$"))
+
+	if err := router.Dispatch(context.Background(), textUpdate("Alice")); err != nil {
+		t.Fatal(err)
+	}
+	if helped {
+		t.Error("state handler didn't keep the update from the router")
+	}
+	if err := router.Dispatch(context.Background(), textUpdate("help")); err != nil {
+		t.Fatal(err)
+	}
+	if !helped {
+		t.Error("update the state handler continued didn't reach the router")
+	}
+}
+
+func TestFSMTimeout(t *testing.T) {
+	storage := NewMemoryStorage()
+	storage.Set(context.Background(), testStateKey, &StateRecord{State: "name", UpdatedAt: time.Now().Add(-time.Hour)})
+	fsm := NewFSM(storage)
+	fsm.Timeout = time.Minute
+	timedOut := false
+	fsm.OnTimeout = func(ctx *Context) error {
+		timedOut = true
+		return nil
+	}
+	fsm.Handle("name", func(ctx *Context) error {
+		t.Error("state handler called after timeout")
+		return nil
+	})
+
+	router := NewRouter(nil)
+	router.Use(fsm.Middleware())
+	if err := router.Dispatch(context.Background(), textUpdate("Alice")); err != nil {
+		t.Fatal(err)
+	}
+	if !timedOut {
+		t.Error("OnTimeout not called")
+	}
+}
+
+func TestFSMExpire(t *testing.T) {
+	storages := map[string]func(t *testing.T) Storage{
+		"memory": func(t *testing.T) Storage { return NewMemoryStorage() },
+		"file": func(t *testing.T) Storage {
+			storage, err := NewFileStorage(filepath.Join(t.TempDir(), "states.json"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			return storage
+		},
+	}
+	for name, newStorage := range storages {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			storage := newStorage(t)
+			stale := StateKey{ChatId: 1, UserId: 2}
+			fresh := StateKey{ChatId: 1, UserId: 3}
+			storage.Set(ctx, stale, &StateRecord{State: "name", UpdatedAt: time.Now().Add(-time.Hour)})
+			storage.Set(ctx, fresh, &StateRecord{State: "name", UpdatedAt: time.Now()})
+
+			fsm := NewFSM(storage)
+			if keys, err := fsm.Expire(ctx); err != nil || len(keys) != 0 {
+				t.Fatalf("Expire() without Timeout = %v, %v, want nothing", keys, err)
+			}
+			fsm.Timeout = time.Minute
+			keys, err := fsm.Expire(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(keys) != 1 || keys[0] != stale {
+				t.Errorf("Expire() = %v, want [%v]", keys, stale)
+			}
+			if record, _ := storage.Get(ctx, stale); record != nil {
+				t.Error("expired record kept")
+			}
+			if record, _ := storage.Get(ctx, fresh); record == nil {
+				t.Error("fresh record removed")
+			}
+		})
+	}
+}
+
+func TestFileStorageReload(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "states.json")
+	storage, err := NewFileStorage(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := StateKey{ChatId: -100, UserId: 2, ThreadId: 7}
+	record := &StateRecord{State: "city", UpdatedAt: time.Now()}
+	if err := storage.Set(ctx, key, record); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded, err := NewFileStorage(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := reloaded.Get(ctx, key)
+	if err != nil || got == nil || got.State != "city" {
+		t.Fatalf("Get() after reload = %+v, %v, want state city", got, err)
+	}
+}
@@ -1,4 +1,4 @@
-package main
+package gogram
 
 type Game struct {
 	// Title of the game
@@ -26,7 +26,7 @@ type Game struct {
 
 type CallbackGame struct {
 	// A placeholder, currently holds no information. Use BotFather to set up your game.
-	_ interface{} `json:"_"`
+	_ interface{}
 }
 
 type GameHighScore struct {
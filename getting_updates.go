@@ -1,3 +1,155 @@
-package main
+package gogram
 
-func (update *Update) GetUpdates()
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	// Number of updates requested by StartPolling in a single getUpdates call
+	pollingLimit = 100
+
+	// Long polling timeout used by StartPolling, in seconds
+	pollingTimeout = 30
+
+	// Time given to the final getUpdates call that confirms delivered updates when polling stops
+	pollingConfirmTimeout = 5 * time.Second
+)
+
+// Bounds of the delay between failed getUpdates calls made by StartPolling.
+// They are variables so tests can shorten them
+var (
+	pollingMinRetryDelay = time.Second
+	pollingMaxRetryDelay = time.Minute
+)
+
+// Use this method to receive incoming updates using long polling. Returns an Array of Update objects.
+// This method will not work if an outgoing webhook is set up.
+// In order to avoid getting duplicate updates, recalculate offset after each server response.
+func (bot *Bot) GetUpdates(ctx context.Context, offset, limit, timeout int, allowedUpdates []string) ([]Update, error) {
+	return bot.getUpdates(ctx, &GetUpdatesParams{
+		Offset:         offset,
+		Limit:          limit,
+		Timeout:        timeout,
+		AllowedUpdates: allowedUpdates,
+	})
+}
+
+func (bot *Bot) getUpdates(ctx context.Context, params *GetUpdatesParams) ([]Update, error) {
+	return Call[[]Update](ctx, bot, "getUpdates", params)
+}
+
+// StartPolling receives updates using long polling and delivers them on the returned channel
+// until ctx is cancelled, after which the channel is closed. allowedUpdates is passed to getUpdates as is.
+//
+// The offset of the next update is kept on the Bot, so polling can be stopped and started again
+// without receiving the same update twice. An update is only considered delivered once it has been
+// received from the channel; when polling stops, the delivered updates are confirmed on the server,
+// so they are not sent again after the process restarts either.
+func (bot *Bot) StartPolling(ctx context.Context, allowedUpdates ...string) <-chan Update {
+	updates := make(chan Update)
+	go bot.poll(ctx, allowedUpdates, updates)
+	return updates
+}
+
+func (bot *Bot) poll(ctx context.Context, allowedUpdates []string, updates chan<- Update) {
+	defer close(updates)
+
+	// Offset the server has already been told about
+	confirmed := bot.nextOffset()
+	defer func() {
+		if offset := bot.nextOffset(); offset != confirmed {
+			bot.confirmUpdates(offset)
+		}
+	}()
+
+	delay := pollingMinRetryDelay
+	for ctx.Err() == nil {
+		offset := bot.nextOffset()
+		batch, err := bot.getUpdates(ctx, &GetUpdatesParams{
+			Offset:         offset,
+			Limit:          pollingLimit,
+			Timeout:        pollingTimeout,
+			AllowedUpdates: allowedUpdates,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			wait := delay
+			var apiErr *APIError
+			if errors.As(err, &apiErr) && apiErr.RetryAfter() > 0 {
+				wait = apiErr.RetryAfter()
+			}
+			bot.logger().WarnContext(ctx, "Polling failed", "error", err, "retry_in", wait)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(wait):
+			}
+			if delay *= 2; delay > pollingMaxRetryDelay {
+				delay = pollingMaxRetryDelay
+			}
+			continue
+		}
+		delay = pollingMinRetryDelay
+		confirmed = offset
+
+		for _, update := range batch {
+			if ctx.Err() != nil {
+				return
+			}
+			select {
+			case updates <- update:
+				bot.setNextOffset(update.UpdateId + 1)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// confirmUpdates tells the server that every update before offset has been handled
+func (bot *Bot) confirmUpdates(offset int) {
+	ctx, cancel := context.WithTimeout(context.Background(), pollingConfirmTimeout)
+	defer cancel()
+	if _, err := bot.getUpdates(ctx, &GetUpdatesParams{Offset: offset, Limit: 1}); err != nil {
+		bot.logger().Warn("Confirming delivered updates failed", "offset", offset, "error", err)
+	}
+}
+
+func (bot *Bot) nextOffset() int {
+	bot.offsetMu.Lock()
+	defer bot.offsetMu.Unlock()
+	return bot.offset
+}
+
+func (bot *Bot) setNextOffset(offset int) {
+	bot.offsetMu.Lock()
+	defer bot.offsetMu.Unlock()
+	bot.offset = offset
+}
+
+// Use this method to specify a URL and receive incoming updates via an outgoing webhook.
+// Whenever there is an update for the bot, we will send an HTTPS POST request to the specified URL,
+// containing a JSON-serialized Update. In case of an unsuccessful request,
+// we will give up after a reasonable amount of attempts. Returns nil on success.
+// Use NewWebhookHandler to serve the requests.
+func (bot *Bot) SetWebhook(ctx context.Context, params *SetWebhookParams) (err error) {
+	_, err = Call[bool](ctx, bot, "setWebhook", params)
+	return
+}
+
+// Use this method to remove webhook integration if you decide to switch back to getUpdates.
+// Returns nil on success.
+func (bot *Bot) DeleteWebhook(ctx context.Context, dropPendingUpdates bool) (err error) {
+	_, err = Call[bool](ctx, bot, "deleteWebhook", &DeleteWebhookParams{DropPendingUpdates: dropPendingUpdates})
+	return
+}
+
+// Use this method to get current webhook status. Requires no parameters. On success, returns a WebhookInfo object.
+// If the bot is using getUpdates, will return an object with the url field empty.
+func (bot *Bot) GetWebhookInfo(ctx context.Context) (*WebhookInfo, error) {
+	return Call[*WebhookInfo](ctx, bot, "getWebhookInfo", nil)
+}
@@ -0,0 +1,225 @@
+package gogram
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testToken = "123:abcdef"
+
+// A getUpdates call received by fakeUpdatesServer
+type updatesCall struct {
+	params GetUpdatesParams
+	time   time.Time
+}
+
+// fakeUpdatesServer serves getUpdates like the Bot API: updates before the requested offset
+// are forgotten, and a request waits a little for new updates if there are none.
+// Responses listed in failures are returned, in order, instead of the first calls.
+type fakeUpdatesServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	pending  []Update
+	failures []Response
+	calls    []updatesCall
+}
+
+func newFakeUpdatesServer(t *testing.T, count int) *fakeUpdatesServer {
+	server := &fakeUpdatesServer{}
+	for id := 1; id <= count; id++ {
+		server.pending = append(server.pending, Update{UpdateId: id})
+	}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serveHTTP))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (server *fakeUpdatesServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/bot"+testToken+"/getUpdates" {
+		http.NotFound(w, r)
+		return
+	}
+	var params GetUpdatesParams
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	server.mu.Lock()
+	server.calls = append(server.calls, updatesCall{params: params, time: time.Now()})
+	if len(server.failures) > 0 {
+		failure := server.failures[0]
+		server.failures = server.failures[1:]
+		server.mu.Unlock()
+		w.WriteHeader(failure.ErrorCode)
+		json.NewEncoder(w).Encode(failure)
+		return
+	}
+	for len(server.pending) > 0 && server.pending[0].UpdateId < params.Offset {
+		server.pending = server.pending[1:]
+	}
+	batch := server.pending
+	if params.Limit > 0 && len(batch) > params.Limit {
+		batch = batch[:params.Limit]
+	}
+	batch = append([]Update{}, batch...)
+	server.mu.Unlock()
+
+	if len(batch) == 0 && params.Timeout > 0 {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(20 * time.Millisecond):
+		}
+	}
+	result, _ := json.Marshal(batch)
+	json.NewEncoder(w).Encode(Response{Ok: true, Result: result})
+}
+
+func (server *fakeUpdatesServer) Calls() []updatesCall {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	return append([]updatesCall{}, server.calls...)
+}
+
+func newTestBot(t *testing.T, serverURL string) *Bot {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	bot, err := NewBot(testToken, WithServerURL(serverURL), WithoutStartupCheck(), WithLogger(logger))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bot
+}
+
+// Returns the next update from updates, failing the test if there is none in time
+func receiveUpdate(t *testing.T, updates <-chan Update) Update {
+	t.Helper()
+	select {
+	case update, ok := <-updates:
+		if !ok {
+			t.Fatal("updates channel closed")
+		}
+		return update
+	case <-time.After(5 * time.Second):
+		t.Fatal("no update received")
+	}
+	return Update{}
+}
+
+// Stops polling and waits for the updates channel to be closed
+func stopPolling(cancel context.CancelFunc, updates <-chan Update) {
+	cancel()
+	for range updates {
+	}
+}
+
+// Shortens the delays between failed getUpdates calls for the duration of the test
+func setRetryDelays(t *testing.T, min, max time.Duration) {
+	previousMin, previousMax := pollingMinRetryDelay, pollingMaxRetryDelay
+	pollingMinRetryDelay, pollingMaxRetryDelay = min, max
+	t.Cleanup(func() {
+		pollingMinRetryDelay, pollingMaxRetryDelay = previousMin, previousMax
+	})
+}
+
+func TestStartPollingRestart(t *testing.T) {
+	const total = 5
+	server := newFakeUpdatesServer(t, total)
+	seen := make(map[int]bool)
+	deliver := func(update Update) {
+		if seen[update.UpdateId] {
+			t.Errorf("update %d delivered twice", update.UpdateId)
+		}
+		seen[update.UpdateId] = true
+	}
+
+	// Receive part of the batch, then stop polling
+	ctx, cancel := context.WithCancel(context.Background())
+	updates := newTestBot(t, server.URL).StartPolling(ctx)
+	lastId := 0
+	for i := 0; i < 2; i++ {
+		update := receiveUpdate(t, updates)
+		deliver(update)
+		lastId = update.UpdateId
+	}
+	cancel()
+	for update := range updates {
+		// Received while polling was stopping, so it counts as delivered too
+		deliver(update)
+		lastId = update.UpdateId
+	}
+
+	calls := server.Calls()
+	confirm := calls[len(calls)-1].params
+	if confirm.Offset != lastId+1 || confirm.Limit != 1 {
+		t.Errorf("confirming call has offset %d and limit %d, want offset %d and limit 1", confirm.Offset, confirm.Limit, lastId+1)
+	}
+
+	// Start again as a new process would, without the offset kept on the Bot
+	ctx, cancel = context.WithCancel(context.Background())
+	updates = newTestBot(t, server.URL).StartPolling(ctx)
+	defer stopPolling(cancel, updates)
+	for len(seen) < total {
+		deliver(receiveUpdate(t, updates))
+	}
+	for id := 1; id <= total; id++ {
+		if !seen[id] {
+			t.Errorf("update %d not delivered", id)
+		}
+	}
+}
+
+func TestStartPollingRetry(t *testing.T) {
+	setRetryDelays(t, 20*time.Millisecond, 30*time.Millisecond)
+	server := newFakeUpdatesServer(t, 1)
+	serverError := Response{ErrorCode: http.StatusInternalServerError, Description: "Internal Server Error"}
+	server.failures = []Response{serverError, serverError, serverError}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	updates := newTestBot(t, server.URL).StartPolling(ctx)
+	defer stopPolling(cancel, updates)
+	if update := receiveUpdate(t, updates); update.UpdateId != 1 {
+		t.Fatalf("got update %d, want 1", update.UpdateId)
+	}
+
+	calls := server.Calls()
+	if len(calls) < 4 {
+		t.Fatalf("got %d calls, want at least 4", len(calls))
+	}
+	// The delay doubles after every failure, up to the maximum
+	for i, want := range []time.Duration{20 * time.Millisecond, 30 * time.Millisecond, 30 * time.Millisecond} {
+		if delay := calls[i+1].time.Sub(calls[i].time); delay < want {
+			t.Errorf("call %d made %s after the previous one, want at least %s", i+2, delay, want)
+		}
+	}
+}
+
+func TestStartPollingRetryAfter(t *testing.T) {
+	setRetryDelays(t, time.Millisecond, time.Millisecond)
+	server := newFakeUpdatesServer(t, 1)
+	server.failures = []Response{{
+		ErrorCode:   http.StatusTooManyRequests,
+		Description: "Too Many Requests: retry after 1",
+		Parameters:  &ResponseParameters{RetryAfter: 1},
+	}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	updates := newTestBot(t, server.URL).StartPolling(ctx)
+	defer stopPolling(cancel, updates)
+	if update := receiveUpdate(t, updates); update.UpdateId != 1 {
+		t.Fatalf("got update %d, want 1", update.UpdateId)
+	}
+
+	calls := server.Calls()
+	if delay := calls[1].time.Sub(calls[0].time); delay < time.Second {
+		t.Errorf("retried after %s, want at least the 1s given in retry_after", delay)
+	}
+}
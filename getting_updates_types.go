@@ -1,4 +1,4 @@
-package main
+package gogram
 
 type Update struct {
 	// The update's unique identifier. Update identifiers start from a certain positive number and increase sequentially.
@@ -83,3 +83,60 @@ type WebhookInfo struct {
 	// Optional. A list of update types the bot is subscribed to. Defaults to all update types except chat_member
 	AllowedUpdates []string `json:"allowed_updates"`
 }
+
+type GetUpdatesParams struct {
+	// Optional. Identifier of the first update to be returned. Must be greater by one than the highest
+	// among the identifiers of previously received updates. By default, updates starting with the earliest
+	// unconfirmed update are returned. An update is considered confirmed as soon as getUpdates is called
+	// with an offset higher than its update_id. The negative offset can be specified to retrieve updates
+	// starting from -offset update from the end of the updates queue. All previous updates will be forgotten.
+	Offset int `json:"offset,omitempty"`
+
+	// Optional. Limits the number of updates to be retrieved. Values between 1-100 are accepted. Defaults to 100.
+	Limit int `json:"limit,omitempty"`
+
+	// Optional. Timeout in seconds for long polling. Defaults to 0, i.e. usual short polling.
+	// Should be positive, short polling should be used for testing purposes only.
+	Timeout int `json:"timeout,omitempty"`
+
+	// Optional. A JSON-serialized list of the update types you want your bot to receive.
+	// For example, specify ["message", "edited_channel_post", "callback_query"] to only receive updates of these types.
+	// Specify an empty list to receive all update types except chat_member (default).
+	// If not specified, the previous setting will be used.
+	AllowedUpdates []string `json:"allowed_updates,omitempty"`
+}
+
+type SetWebhookParams struct {
+	// HTTPS URL to send updates to. Use an empty string to remove webhook integration
+	Url string `json:"url"`
+
+	// Optional. Upload your public key certificate so that the root certificate in use can be checked.
+	// See our self-signed guide for details.
+	Certificate *InputFile `json:"certificate,omitempty"`
+
+	// Optional. The fixed IP address which will be used to send webhook requests instead of the IP address resolved through DNS
+	IpAddress string `json:"ip_address,omitempty"`
+
+	// Optional. The maximum allowed number of simultaneous HTTPS connections to the webhook for update delivery, 1-100.
+	// Defaults to 40. Use lower values to limit the load on your bot's server, and higher values to increase your bot's throughput.
+	MaxConnections int `json:"max_connections,omitempty"`
+
+	// Optional. A JSON-serialized list of the update types you want your bot to receive.
+	// For example, specify ["message", "edited_channel_post", "callback_query"] to only receive updates of these types.
+	// Specify an empty list to receive all update types except chat_member (default).
+	// If not specified, the previous setting will be used.
+	AllowedUpdates []string `json:"allowed_updates,omitempty"`
+
+	// Optional. Pass True to drop all pending updates
+	DropPendingUpdates bool `json:"drop_pending_updates,omitempty"`
+
+	// Optional. A secret token to be sent in a header “X-Telegram-Bot-Api-Secret-Token” in every webhook request, 1-256 characters.
+	// Only characters A-Z, a-z, 0-9, _ and - are allowed.
+	// The header is useful to ensure that the request comes from a webhook set by you.
+	SecretToken string `json:"secret_token,omitempty"`
+}
+
+type DeleteWebhookParams struct {
+	// Optional. Pass True to drop all pending updates
+	DropPendingUpdates bool `json:"drop_pending_updates,omitempty"`
+}
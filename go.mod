@@ -1,3 +1,3 @@
-module gogram_tg
+module github.com/Ivanprogram-creaator/Gogram
 
-go 1.20
+go 1.21
@@ -1,4 +1,4 @@
-package main
+package gogram
 
 type InlineQuery struct {
 	// Unique identifier for this query
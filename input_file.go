@@ -0,0 +1,194 @@
+package gogram
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+)
+
+// InputFile represents the contents of a file to be sent. Exactly one of FileId, Url,
+// Path or Reader should be set. Files given by Path or Reader are uploaded using
+// multipart/form-data: a request containing such a file is switched to multipart
+// automatically, and the file is referenced as “attach://<file_attach_name>”,
+// including inside InputMedia objects.
+//
+// An InputFile with a Path or Reader must not be used by several requests at the same time.
+type InputFile struct {
+	// Identifier of a file that exists on the Telegram servers (recommended)
+	FileId string
+
+	// HTTP URL for Telegram to get the file from the Internet
+	Url string
+
+	// Path of a local file to upload
+	Path string
+
+	// Contents of the file to upload. If it is an io.Closer, it is closed after the upload
+	Reader io.Reader
+
+	// Name of the uploaded file. Defaults to the base name of Path
+	Name string
+
+	// Name of the multipart/form-data part the file is uploaded under
+	attachName string
+}
+
+// Returns InputFile referring to a file that exists on the Telegram servers
+func InputFileFromId(fileId string) *InputFile {
+	return &InputFile{FileId: fileId}
+}
+
+// Returns InputFile that Telegram downloads from url
+func InputFileFromUrl(url string) *InputFile {
+	return &InputFile{Url: url}
+}
+
+// Returns InputFile uploading the local file at path
+func InputFileFromPath(path string) *InputFile {
+	return &InputFile{Path: path}
+}
+
+// Returns InputFile uploading the contents of reader under the given file name
+func InputFileFromReader(name string, reader io.Reader) *InputFile {
+	return &InputFile{Name: name, Reader: reader}
+}
+
+// Reports whether the file has to be uploaded using multipart/form-data
+func (file *InputFile) needsUpload() bool {
+	return file.Reader != nil || file.Path != ""
+}
+
+func (file *InputFile) MarshalJSON() ([]byte, error) {
+	switch {
+	case file.needsUpload():
+		if file.attachName == "" {
+			return nil, errors.New("gogram: InputFile with contents can only be sent in a request")
+		}
+		return json.Marshal("attach://" + file.attachName)
+	case file.FileId != "":
+		return json.Marshal(file.FileId)
+	case file.Url != "":
+		return json.Marshal(file.Url)
+	}
+	return nil, errors.New("gogram: InputFile has neither file id, URL, path nor reader")
+}
+
+// Returns the contents and the name of the file to upload
+func (file *InputFile) open() (io.ReadCloser, string, error) {
+	name := file.Name
+	if file.Reader != nil {
+		if name == "" {
+			name = file.attachName
+		}
+		if closer, ok := file.Reader.(io.ReadCloser); ok {
+			return closer, name, nil
+		}
+		return io.NopCloser(file.Reader), name, nil
+	}
+	if name == "" {
+		name = filepath.Base(file.Path)
+	}
+	f, err := os.Open(file.Path)
+	return f, name, err
+}
+
+// Finds the files in params which have to be uploaded and assigns them attach names.
+// The files are looked up in exported struct fields, pointers, interfaces and slices,
+// so files inside arrays of InputMedia are found as well.
+func collectUploads(params any) []*InputFile {
+	var files []*InputFile
+	seen := make(map[*InputFile]bool)
+
+	var walk func(value reflect.Value)
+	walk = func(value reflect.Value) {
+		switch value.Kind() {
+		case reflect.Pointer:
+			if value.IsNil() {
+				return
+			}
+			if file, ok := value.Interface().(*InputFile); ok {
+				if file.needsUpload() && !seen[file] {
+					seen[file] = true
+					file.attachName = fmt.Sprintf("file%d", len(files))
+					files = append(files, file)
+				}
+				return
+			}
+			walk(value.Elem())
+		case reflect.Interface:
+			if !value.IsNil() {
+				walk(value.Elem())
+			}
+		case reflect.Struct:
+			for i := 0; i < value.NumField(); i++ {
+				if value.Type().Field(i).IsExported() {
+					walk(value.Field(i))
+				}
+			}
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < value.Len(); i++ {
+				walk(value.Index(i))
+			}
+		}
+	}
+	walk(reflect.ValueOf(params))
+	return files
+}
+
+// Writes params and files as multipart/form-data. Every top-level parameter becomes
+// a form field: strings are written as is, other values as JSON.
+func writeMultipart(writer *multipart.Writer, params []byte, files []*InputFile) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(params, &fields); err != nil {
+		return fmt.Errorf("parameters must be encoded as a JSON object: %w", err)
+	}
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		raw := fields[name]
+		if string(raw) == "null" {
+			continue
+		}
+		value := string(raw)
+		if raw[0] == '"' {
+			if err := json.Unmarshal(raw, &value); err != nil {
+				return err
+			}
+		}
+		if err := writer.WriteField(name, value); err != nil {
+			return err
+		}
+	}
+
+	for _, file := range files {
+		if err := writeFile(writer, file); err != nil {
+			return err
+		}
+	}
+	return writer.Close()
+}
+
+func writeFile(writer *multipart.Writer, file *InputFile) error {
+	contents, name, err := file.open()
+	if err != nil {
+		return err
+	}
+	defer contents.Close()
+
+	part, err := writer.CreateFormFile(file.attachName, name)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(part, contents)
+	return err
+}
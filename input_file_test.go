@@ -0,0 +1,192 @@
+package gogram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// An uploaded file part of a multipart request
+type uploadedFile struct {
+	name     string
+	contents string
+}
+
+// A request received by uploadServer
+type uploadRequest struct {
+	contentType string
+	fields      map[string]string
+	files       map[string]uploadedFile
+	json        map[string]any
+}
+
+// Starts a Bot API server which records the requests it receives and answers them with result
+func uploadServer(t *testing.T, result string) (*Bot, func() uploadRequest) {
+	var mu sync.Mutex
+	var last uploadRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := uploadRequest{fields: map[string]string{}, files: map[string]uploadedFile{}}
+		request.contentType, _, _ = mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if request.contentType == "multipart/form-data" {
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			for name, values := range r.MultipartForm.Value {
+				request.fields[name] = values[0]
+			}
+			for name, headers := range r.MultipartForm.File {
+				file, err := headers[0].Open()
+				if err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				contents, _ := io.ReadAll(file)
+				file.Close()
+				request.files[name] = uploadedFile{name: headers[0].Filename, contents: string(contents)}
+			}
+		} else {
+			json.NewDecoder(r.Body).Decode(&request.json)
+		}
+		mu.Lock()
+		last = request
+		mu.Unlock()
+		w.Write([]byte(`{"ok":true,"result":` + result + `}`))
+	}))
+	t.Cleanup(server.Close)
+	return newTestBot(t, server.URL), func() uploadRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return last
+	}
+}
+
+func TestUploadDocument(t *testing.T) {
+	bot, lastRequest := uploadServer(t, `{"message_id":1}`)
+	path := filepath.Join(t.TempDir(), "report.txt")
+	if err := os.WriteFile(path, []byte("report contents"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := bot.SendDocument(context.Background(), &SendDocumentParams{
+		ChatId:    int64(1),
+		Document:  InputFileFromPath(path),
+		Thumbnail: InputFileFromReader("thumb.jpg", strings.NewReader("thumbnail contents")),
+		Caption:   "Monthly report",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request := lastRequest()
+	if request.contentType != "multipart/form-data" {
+		t.Fatalf("content type %q, want multipart/form-data", request.contentType)
+	}
+	wantFields := map[string]string{
+		"chat_id":   "1",
+		"document":  "attach://file0",
+		"thumbnail": "attach://file1",
+		"caption":   "Monthly report",
+	}
+	if !reflect.DeepEqual(request.fields, wantFields) {
+		t.Errorf("fields = %v, want %v", request.fields, wantFields)
+	}
+	wantFiles := map[string]uploadedFile{
+		"file0": {name: "report.txt", contents: "report contents"},
+		"file1": {name: "thumb.jpg", contents: "thumbnail contents"},
+	}
+	if !reflect.DeepEqual(request.files, wantFiles) {
+		t.Errorf("files = %v, want %v", request.files, wantFiles)
+	}
+}
+
+func TestUploadMediaGroup(t *testing.T) {
+	bot, lastRequest := uploadServer(t, `[{"message_id":1},{"message_id":2},{"message_id":3}]`)
+	_, err := bot.SendMediaGroup(context.Background(), &SendMediaGroupParams{
+		ChatId: "@channel",
+		Media: []InputMedia{
+			InputMediaPhoto{Media: InputFileFromId("photo-id"), Caption: "Album"},
+			InputMediaPhoto{Media: InputFileFromReader("cat.jpg", strings.NewReader("cat"))},
+			InputMediaVideo{
+				Media:     InputFileFromReader("clip.mp4", strings.NewReader("clip")),
+				Thumbnail: InputFileFromReader("clip.jpg", strings.NewReader("clip thumbnail")),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request := lastRequest()
+	if request.fields["chat_id"] != "@channel" {
+		t.Errorf("chat_id = %q, want @channel", request.fields["chat_id"])
+	}
+	var media []map[string]any
+	if err := json.Unmarshal([]byte(request.fields["media"]), &media); err != nil {
+		t.Fatalf("decoding media field %q: %v", request.fields["media"], err)
+	}
+	wantMedia := []map[string]any{
+		{"type": "photo", "media": "photo-id", "caption": "Album"},
+		{"type": "photo", "media": "attach://file0"},
+		{"type": "video", "media": "attach://file1", "thumbnail": "attach://file2"},
+	}
+	if !reflect.DeepEqual(media, wantMedia) {
+		t.Errorf("media = %v, want %v", media, wantMedia)
+	}
+	wantFiles := map[string]uploadedFile{
+		"file0": {name: "cat.jpg", contents: "cat"},
+		"file1": {name: "clip.mp4", contents: "clip"},
+		"file2": {name: "clip.jpg", contents: "clip thumbnail"},
+	}
+	if !reflect.DeepEqual(request.files, wantFiles) {
+		t.Errorf("files = %v, want %v", request.files, wantFiles)
+	}
+}
+
+func TestRequestWithoutUploads(t *testing.T) {
+	bot, lastRequest := uploadServer(t, `{"message_id":1}`)
+	_, err := bot.SendDocument(context.Background(), &SendDocumentParams{ChatId: int64(1), Document: InputFileFromId("doc-id")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	request := lastRequest()
+	if request.contentType != "application/json" {
+		t.Fatalf("content type %q, want application/json", request.contentType)
+	}
+	if request.json["document"] != "doc-id" {
+		t.Errorf("document = %v, want doc-id", request.json["document"])
+	}
+}
+
+func TestUploadOpenError(t *testing.T) {
+	bot, _ := uploadServer(t, `{"message_id":1}`)
+	path := filepath.Join(t.TempDir(), "missing.txt")
+	_, err := bot.SendDocument(context.Background(), &SendDocumentParams{ChatId: int64(1), Document: InputFileFromPath(path)})
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("error = %v, want one wrapping fs.ErrNotExist", err)
+	}
+}
+
+func TestInputFileMarshalOutsideRequest(t *testing.T) {
+	if _, err := json.Marshal(InputFileFromPath("a.txt")); err == nil {
+		t.Error("marshalling a file to upload outside of a request succeeded")
+	}
+	if _, err := json.Marshal(&InputFile{}); err == nil {
+		t.Error("marshalling an empty file succeeded")
+	}
+	data, err := json.Marshal(InputFileFromUrl("https://example.com/a.jpg"))
+	if err != nil || string(data) != `"https://example.com/a.jpg"` {
+		t.Errorf("Marshal() = %s, %v, want the URL", data, err)
+	}
+}
@@ -0,0 +1,57 @@
+package gogram
+
+import "encoding/json"
+
+// InputMedia represents the content of a media message to be sent. It is implemented by
+// InputMediaPhoto, InputMediaVideo, InputMediaAnimation, InputMediaAudio and InputMediaDocument,
+// whose type field is filled in when they are marshalled.
+type InputMedia interface {
+	// Returns the value of the type field
+	inputMediaType() string
+}
+
+func (InputMediaPhoto) inputMediaType() string     { return "photo" }
+func (InputMediaVideo) inputMediaType() string     { return "video" }
+func (InputMediaAnimation) inputMediaType() string { return "animation" }
+func (InputMediaAudio) inputMediaType() string     { return "audio" }
+func (InputMediaDocument) inputMediaType() string  { return "document" }
+
+func (media InputMediaPhoto) MarshalJSON() ([]byte, error) {
+	type inputMediaPhoto InputMediaPhoto
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		inputMediaPhoto
+	}{media.inputMediaType(), inputMediaPhoto(media)})
+}
+
+func (media InputMediaVideo) MarshalJSON() ([]byte, error) {
+	type inputMediaVideo InputMediaVideo
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		inputMediaVideo
+	}{media.inputMediaType(), inputMediaVideo(media)})
+}
+
+func (media InputMediaAnimation) MarshalJSON() ([]byte, error) {
+	type inputMediaAnimation InputMediaAnimation
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		inputMediaAnimation
+	}{media.inputMediaType(), inputMediaAnimation(media)})
+}
+
+func (media InputMediaAudio) MarshalJSON() ([]byte, error) {
+	type inputMediaAudio InputMediaAudio
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		inputMediaAudio
+	}{media.inputMediaType(), inputMediaAudio(media)})
+}
+
+func (media InputMediaDocument) MarshalJSON() ([]byte, error) {
+	type inputMediaDocument InputMediaDocument
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		inputMediaDocument
+	}{media.inputMediaType(), inputMediaDocument(media)})
+}
@@ -0,0 +1,53 @@
+package gogram
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// RequestFunc makes a Bot API request, like Bot.MakeRequest
+type RequestFunc func(ctx context.Context, method string, params any) (*Response, error)
+
+// Interceptor wraps the Bot API requests made by a bot, the way an http.RoundTripper wraps
+// HTTP requests. It can change the method and parameters, inspect or replace the response,
+// or call next several times or not at all.
+type Interceptor func(next RequestFunc) RequestFunc
+
+// Delay before repeating a request failed because of flood control if the error doesn't say how long to wait
+const floodWaitMinDelay = time.Second
+
+// RetryFloodWait returns an interceptor which repeats requests failed because of flood control
+// after the time given in the error, or after a second if none is given, at most maxRetries times.
+// Requests uploading a file from an io.Reader are not repeated, as the file can't be read again.
+func RetryFloodWait(maxRetries int) Interceptor {
+	return func(next RequestFunc) RequestFunc {
+		return func(ctx context.Context, method string, params any) (*Response, error) {
+			response, err := next(ctx, method, params)
+			for retry := 0; retry < maxRetries && errors.Is(err, ErrTooManyRequests) && !hasReaderUploads(params); retry++ {
+				delay := floodWaitMinDelay
+				var apiErr *APIError
+				if errors.As(err, &apiErr) && apiErr.RetryAfter() > delay {
+					delay = apiErr.RetryAfter()
+				}
+				select {
+				case <-ctx.Done():
+					return response, err
+				case <-time.After(delay):
+				}
+				response, err = next(ctx, method, params)
+			}
+			return response, err
+		}
+	}
+}
+
+// Reports whether params contain a file uploaded from an io.Reader
+func hasReaderUploads(params any) bool {
+	for _, file := range collectUploads(params) {
+		if file.Reader != nil {
+			return true
+		}
+	}
+	return false
+}
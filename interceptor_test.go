@@ -0,0 +1,99 @@
+package gogram
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Returns a RequestFunc failing with errs in order, then succeeding, and the number of calls made to it
+func failingRequests(errs ...error) (RequestFunc, *int) {
+	calls := 0
+	return func(ctx context.Context, method string, params any) (*Response, error) {
+		calls++
+		if calls <= len(errs) {
+			return nil, errs[calls-1]
+		}
+		return &Response{Ok: true}, nil
+	}, &calls
+}
+
+func floodError(retryAfter int) error {
+	return &APIError{
+		Method:      "sendMessage",
+		ErrorCode:   http.StatusTooManyRequests,
+		Description: "Too Many Requests",
+		Parameters:  ResponseParameters{RetryAfter: retryAfter},
+	}
+}
+
+func TestRetryFloodWait(t *testing.T) {
+	request, calls := failingRequests(floodError(1))
+	start := time.Now()
+	if _, err := RetryFloodWait(3)(request)(context.Background(), "sendMessage", nil); err != nil {
+		t.Fatal(err)
+	}
+	if *calls != 2 {
+		t.Errorf("made %d calls, want 2", *calls)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %s, want at least the 1s given in retry_after", elapsed)
+	}
+}
+
+func TestRetryFloodWaitWithoutRetryAfter(t *testing.T) {
+	request, calls := failingRequests(floodError(0))
+	start := time.Now()
+	if _, err := RetryFloodWait(3)(request)(context.Background(), "sendMessage", nil); err != nil {
+		t.Fatal(err)
+	}
+	if *calls != 2 {
+		t.Errorf("made %d calls, want 2", *calls)
+	}
+	if elapsed := time.Since(start); elapsed < floodWaitMinDelay {
+		t.Errorf("retried after %s, want at least %s", elapsed, floodWaitMinDelay)
+	}
+}
+
+func TestRetryFloodWaitGivesUp(t *testing.T) {
+	other := errors.New("connection reset")
+	request, calls := failingRequests(other)
+	if _, err := RetryFloodWait(3)(request)(context.Background(), "sendMessage", nil); !errors.Is(err, other) {
+		t.Errorf("error = %v, want %v", err, other)
+	}
+	if *calls != 1 {
+		t.Errorf("retried a request failed for another reason: made %d calls", *calls)
+	}
+
+	request, calls = failingRequests(floodError(0), floodError(0))
+	if _, err := RetryFloodWait(0)(request)(context.Background(), "sendMessage", nil); !errors.Is(err, ErrTooManyRequests) {
+		t.Errorf("error = %v, want ErrTooManyRequests", err)
+	}
+	if *calls != 1 {
+		t.Errorf("made %d calls with maxRetries 0, want 1", *calls)
+	}
+
+	request, calls = failingRequests(floodError(0))
+	params := &SendDocumentParams{Document: &InputFile{Name: "a.txt", Reader: strings.NewReader("a")}}
+	if _, err := RetryFloodWait(3)(request)(context.Background(), "sendDocument", params); !errors.Is(err, ErrTooManyRequests) {
+		t.Errorf("error = %v, want ErrTooManyRequests", err)
+	}
+	if *calls != 1 {
+		t.Errorf("retried a request uploading from a reader: made %d calls", *calls)
+	}
+}
+
+func TestRetryFloodWaitCancel(t *testing.T) {
+	request, calls := failingRequests(floodError(60))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := RetryFloodWait(3)(request)(ctx, "sendMessage", nil); !errors.Is(err, ErrTooManyRequests) {
+		t.Errorf("error = %v, want ErrTooManyRequests", err)
+	}
+	if *calls != 1 {
+		t.Errorf("made %d calls after the context was cancelled, want 1", *calls)
+	}
+}
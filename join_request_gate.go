@@ -0,0 +1,160 @@
+package gogram
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// JoinRequestCheck decides whether a chat join request is approved, for example by sending
+// the user a captcha in a private chat (request.UserChatId) and waiting for the answer.
+// ctx is cancelled when the gate times out or the request is resolved by JoinRequestGate.Resolve.
+// If the check returns an error, the request keeps waiting for Resolve or the timeout.
+type JoinRequestCheck func(ctx context.Context, request *ChatJoinRequest) (approve bool, err error)
+
+// JoinRequestGate holds chat join requests until they are approved or declined by its Check,
+// by a call to Resolve, or declined when Timeout runs out.
+type JoinRequestGate struct {
+	// Bot used to approve and decline the requests
+	Bot *Bot
+
+	// Check run for every held request. If nil, requests wait for Resolve
+	Check JoinRequestCheck
+
+	// How long a request is held before it is declined. Zero means it is held until resolved
+	Timeout time.Duration
+
+	mu      sync.Mutex
+	pending map[joinRequestKey]chan bool
+}
+
+type joinRequestKey struct {
+	chatId int64
+	userId int64
+}
+
+// Creates new join request gate which decides on the requests with check
+func NewJoinRequestGate(bot *Bot, check JoinRequestCheck, timeout time.Duration) *JoinRequestGate {
+	return &JoinRequestGate{
+		Bot:     bot,
+		Check:   check,
+		Timeout: timeout,
+	}
+}
+
+// Hold starts holding the request and returns immediately. The request is approved or declined
+// in the background once a decision is made. ctx limits how long the request is held: if it is
+// cancelled first, the request is left for the chat administrators. Requests which are
+// already held are ignored.
+func (gate *JoinRequestGate) Hold(ctx context.Context, request *ChatJoinRequest) {
+	key := joinRequestKey{chatId: request.Chat.Id, userId: request.From.Id}
+	decision := make(chan bool, 1)
+
+	gate.mu.Lock()
+	if gate.pending == nil {
+		gate.pending = make(map[joinRequestKey]chan bool)
+	}
+	if _, ok := gate.pending[key]; ok {
+		gate.mu.Unlock()
+		return
+	}
+	gate.pending[key] = decision
+	gate.mu.Unlock()
+
+	go gate.wait(ctx, key, request, decision)
+}
+
+// Resolve approves or declines the held request of the user to join the chat.
+// It reports whether such a request was held.
+func (gate *JoinRequestGate) Resolve(chatId, userId int64, approve bool) bool {
+	gate.mu.Lock()
+	decision, ok := gate.pending[joinRequestKey{chatId: chatId, userId: userId}]
+	gate.mu.Unlock()
+	if !ok {
+		return false
+	}
+	select {
+	case decision <- approve:
+	default:
+		// A decision has already been made
+	}
+	return true
+}
+
+// Pending reports whether the request of the user to join the chat is held
+func (gate *JoinRequestGate) Pending(chatId, userId int64) bool {
+	gate.mu.Lock()
+	defer gate.mu.Unlock()
+	_, ok := gate.pending[joinRequestKey{chatId: chatId, userId: userId}]
+	return ok
+}
+
+// Waits for a decision on the request and applies it
+func (gate *JoinRequestGate) wait(ctx context.Context, key joinRequestKey, request *ChatJoinRequest, decision chan bool) {
+	defer func() {
+		gate.mu.Lock()
+		delete(gate.pending, key)
+		gate.mu.Unlock()
+	}()
+
+	waitCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	timeout := make(<-chan time.Time)
+	if gate.Timeout > 0 {
+		timer := time.NewTimer(gate.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	if gate.Check != nil {
+		go gate.check(waitCtx, request, decision)
+	}
+
+	var approve bool
+	select {
+	case approve = <-decision:
+	case <-timeout:
+		approve = false
+	case <-ctx.Done():
+		return
+	}
+	// Stop the check before answering, so it does not keep waiting for the user
+	cancel()
+
+	// The request has to be answered even if the decision was made just as ctx was cancelled
+	answerCtx := context.WithoutCancel(ctx)
+	var err error
+	if approve {
+		err = gate.Bot.ApproveChatJoinRequest(answerCtx, key.chatId, key.userId)
+	} else {
+		err = gate.Bot.DeclineChatJoinRequest(answerCtx, key.chatId, key.userId)
+	}
+	if err != nil {
+		gate.Bot.logger().LogAttrs(ctx, slog.LevelError, "Answering chat join request failed",
+			slog.Int64("chat_id", key.chatId),
+			slog.Int64("user_id", key.userId),
+			slog.Bool("approve", approve),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// Runs the check and sends its decision, unless it failed or was cancelled
+func (gate *JoinRequestGate) check(ctx context.Context, request *ChatJoinRequest, decision chan bool) {
+	approve, err := gate.Check(ctx, request)
+	if ctx.Err() != nil {
+		return
+	}
+	if err != nil {
+		gate.Bot.logger().LogAttrs(ctx, slog.LevelError, "Chat join request check failed",
+			slog.Int64("chat_id", request.Chat.Id),
+			slog.Int64("user_id", request.From.Id),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+	select {
+	case decision <- approve:
+	default:
+	}
+}
@@ -0,0 +1,169 @@
+package gogram
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"testing"
+	"time"
+)
+
+// A call answering a chat join request
+type joinRequestAnswer struct {
+	method string
+	chatId int64
+	userId int64
+}
+
+// Starts a Bot API server which sends the chat join request answers it receives to the returned channel
+func joinRequestServer(t *testing.T) (*Bot, <-chan joinRequestAnswer) {
+	answers := make(chan joinRequestAnswer, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var params struct {
+			ChatId int64 `json:"chat_id"`
+			UserId int64 `json:"user_id"`
+		}
+		json.NewDecoder(r.Body).Decode(&params)
+		answers <- joinRequestAnswer{method: path.Base(r.URL.Path), chatId: params.ChatId, userId: params.UserId}
+		w.Write([]byte(`{"ok":true,"result":true}`))
+	}))
+	t.Cleanup(server.Close)
+	return newTestBot(t, server.URL), answers
+}
+
+// Returns a request of user 2 to join chat -100
+func joinRequest() *ChatJoinRequest {
+	return &ChatJoinRequest{Chat: &Chat{Id: -100, Type: "supergroup"}, From: &User{Id: 2}, UserChatId: 2}
+}
+
+// Checks that the next answer is the given method for user 2 in chat -100 and that no other answer follows
+func expectJoinRequestAnswer(t *testing.T, answers <-chan joinRequestAnswer, method string) {
+	t.Helper()
+	select {
+	case answer := <-answers:
+		if want := (joinRequestAnswer{method: method, chatId: -100, userId: 2}); answer != want {
+			t.Errorf("answered %+v, want %+v", answer, want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("request not answered with %s", method)
+	}
+	expectNoJoinRequestAnswer(t, answers)
+}
+
+// Checks that the request isn't answered for a while
+func expectNoJoinRequestAnswer(t *testing.T, answers <-chan joinRequestAnswer) {
+	t.Helper()
+	select {
+	case answer := <-answers:
+		t.Errorf("unexpected answer %+v", answer)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// Waits for the gate to stop holding the request of user 2 to join chat -100
+func waitNotPending(t *testing.T, gate *JoinRequestGate) {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); gate.Pending(-100, 2); {
+		if time.Now().After(deadline) {
+			t.Fatal("request still held")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// Returns a check which waits for its ctx to be cancelled and closes cancelled then
+func blockingCheck(started chan<- struct{}, cancelled chan<- struct{}) JoinRequestCheck {
+	return func(ctx context.Context, request *ChatJoinRequest) (bool, error) {
+		started <- struct{}{}
+		<-ctx.Done()
+		close(cancelled)
+		return true, nil
+	}
+}
+
+// Waits for a signal on ch, failing the test if it doesn't come in time
+func waitSignal(t *testing.T, ch <-chan struct{}, what string) {
+	t.Helper()
+	select {
+	case <-ch:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("%s didn't happen", what)
+	}
+}
+
+func TestJoinRequestGateApprove(t *testing.T) {
+	bot, answers := joinRequestServer(t)
+	gate := NewJoinRequestGate(bot, func(ctx context.Context, request *ChatJoinRequest) (bool, error) {
+		return request.From.Id == 2, nil
+	}, time.Minute)
+
+	gate.Hold(context.Background(), joinRequest())
+	expectJoinRequestAnswer(t, answers, "approveChatJoinRequest")
+	waitNotPending(t, gate)
+}
+
+func TestJoinRequestGateTimeout(t *testing.T) {
+	bot, answers := joinRequestServer(t)
+	started, cancelled := make(chan struct{}, 1), make(chan struct{})
+	gate := NewJoinRequestGate(bot, blockingCheck(started, cancelled), 20*time.Millisecond)
+
+	gate.Hold(context.Background(), joinRequest())
+	expectJoinRequestAnswer(t, answers, "declineChatJoinRequest")
+	waitSignal(t, cancelled, "cancelling the check on timeout")
+	waitNotPending(t, gate)
+}
+
+func TestJoinRequestGateResolve(t *testing.T) {
+	bot, answers := joinRequestServer(t)
+	started, cancelled := make(chan struct{}, 1), make(chan struct{})
+	gate := NewJoinRequestGate(bot, blockingCheck(started, cancelled), time.Minute)
+
+	if gate.Resolve(-100, 2, true) {
+		t.Error("Resolve() of a request which isn't held = true")
+	}
+	gate.Hold(context.Background(), joinRequest())
+	waitSignal(t, started, "starting the check")
+	if !gate.Pending(-100, 2) {
+		t.Error("Pending() of a held request = false")
+	}
+	// The slow check would approve the request once cancelled, but Resolve wins
+	if !gate.Resolve(-100, 2, false) {
+		t.Error("Resolve() of a held request = false")
+	}
+	expectJoinRequestAnswer(t, answers, "declineChatJoinRequest")
+	waitSignal(t, cancelled, "cancelling the check on Resolve")
+	waitNotPending(t, gate)
+}
+
+func TestJoinRequestGateDuplicateHold(t *testing.T) {
+	bot, answers := joinRequestServer(t)
+	started, cancelled := make(chan struct{}, 2), make(chan struct{})
+	gate := NewJoinRequestGate(bot, blockingCheck(started, cancelled), time.Minute)
+
+	gate.Hold(context.Background(), joinRequest())
+	gate.Hold(context.Background(), joinRequest())
+	waitSignal(t, started, "starting the check")
+	gate.Resolve(-100, 2, true)
+	expectJoinRequestAnswer(t, answers, "approveChatJoinRequest")
+	select {
+	case <-started:
+		t.Error("check run for the duplicate request")
+	default:
+	}
+}
+
+func TestJoinRequestGateCancel(t *testing.T) {
+	bot, answers := joinRequestServer(t)
+	started, cancelled := make(chan struct{}, 1), make(chan struct{})
+	gate := NewJoinRequestGate(bot, blockingCheck(started, cancelled), time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	gate.Hold(ctx, joinRequest())
+	waitSignal(t, started, "starting the check")
+	cancel()
+	waitSignal(t, cancelled, "cancelling the check with ctx")
+	waitNotPending(t, gate)
+	expectNoJoinRequestAnswer(t, answers)
+}
@@ -0,0 +1,58 @@
+package gogram
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MenuButton describes the bot's menu button in a private chat. It is implemented by
+// MenuButtonCommands, MenuButtonWebApp and MenuButtonDefault, whose type field is filled in
+// when they are marshalled. Menu buttons returned by GetChatMenuButton are pointers to these types.
+type MenuButton interface {
+	// Returns the value of the type field
+	menuButtonType() string
+}
+
+func (MenuButtonCommands) menuButtonType() string { return "commands" }
+func (MenuButtonWebApp) menuButtonType() string   { return "web_app" }
+func (MenuButtonDefault) menuButtonType() string  { return "default" }
+
+func (button MenuButtonCommands) MarshalJSON() ([]byte, error) {
+	return marshalType(button.menuButtonType())
+}
+
+func (button MenuButtonWebApp) MarshalJSON() ([]byte, error) {
+	type menuButtonWebApp MenuButtonWebApp
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		menuButtonWebApp
+	}{button.menuButtonType(), menuButtonWebApp(button)})
+}
+
+func (button MenuButtonDefault) MarshalJSON() ([]byte, error) {
+	return marshalType(button.menuButtonType())
+}
+
+// Decodes a MenuButton choosing its type by the type field
+func unmarshalMenuButton(data []byte) (MenuButton, error) {
+	var header struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+
+	switch header.Type {
+	case "commands":
+		return &MenuButtonCommands{}, nil
+	case "default":
+		return &MenuButtonDefault{}, nil
+	case "web_app":
+		button := &MenuButtonWebApp{}
+		if err := json.Unmarshal(data, button); err != nil {
+			return nil, err
+		}
+		return button, nil
+	}
+	return nil, fmt.Errorf("unknown menu button type %q", header.Type)
+}
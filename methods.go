@@ -0,0 +1,487 @@
+package gogram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Use this method to send text messages. On success, the sent Message is returned.
+func (bot *Bot) SendMessage(ctx context.Context, params *SendMessageParams) (*Message, error) {
+	return Call[*Message](ctx, bot, "sendMessage", params)
+}
+
+// Use this method to forward messages of any kind. Service messages can't be forwarded.
+// On success, the sent Message is returned.
+func (bot *Bot) ForwardMessage(ctx context.Context, params *ForwardMessageParams) (*Message, error) {
+	return Call[*Message](ctx, bot, "forwardMessage", params)
+}
+
+// Use this method to copy messages of any kind. Service messages and invoice messages can't be copied.
+// A quiz poll can be copied only if the value of the field correct_option_id is known to the bot.
+// The method is analogous to the method forwardMessage, but the copied message doesn't have
+// a link to the original message. Returns the MessageId of the sent message on success.
+func (bot *Bot) CopyMessage(ctx context.Context, params *CopyMessageParams) (*MessageId, error) {
+	return Call[*MessageId](ctx, bot, "copyMessage", params)
+}
+
+// Use this method to send photos. On success, the sent Message is returned.
+func (bot *Bot) SendPhoto(ctx context.Context, params *SendPhotoParams) (*Message, error) {
+	return Call[*Message](ctx, bot, "sendPhoto", params)
+}
+
+// Use this method to send audio files, if you want Telegram clients to display them in the music player.
+// Your audio must be in the .MP3 or .M4A format. On success, the sent Message is returned.
+// Bots can currently send audio files of up to 50 MB in size, this limit may be changed in the future.
+// For sending voice messages, use the SendVoice method instead.
+func (bot *Bot) SendAudio(ctx context.Context, params *SendAudioParams) (*Message, error) {
+	return Call[*Message](ctx, bot, "sendAudio", params)
+}
+
+// Use this method to send general files. On success, the sent Message is returned.
+// Bots can currently send files of any type of up to 50 MB in size, this limit may be changed in the future.
+func (bot *Bot) SendDocument(ctx context.Context, params *SendDocumentParams) (*Message, error) {
+	return Call[*Message](ctx, bot, "sendDocument", params)
+}
+
+// Use this method to send video files, Telegram clients support MPEG4 videos (other formats may be sent as Document).
+// On success, the sent Message is returned. Bots can currently send video files of up to 50 MB in size,
+// this limit may be changed in the future.
+func (bot *Bot) SendVideo(ctx context.Context, params *SendVideoParams) (*Message, error) {
+	return Call[*Message](ctx, bot, "sendVideo", params)
+}
+
+// Use this method to send animation files (GIF or H.264/MPEG-4 AVC video without sound).
+// On success, the sent Message is returned. Bots can currently send animation files of up to 50 MB in size,
+// this limit may be changed in the future.
+func (bot *Bot) SendAnimation(ctx context.Context, params *SendAnimationParams) (*Message, error) {
+	return Call[*Message](ctx, bot, "sendAnimation", params)
+}
+
+// Use this method to send audio files, if you want Telegram clients to display the file as a playable voice message.
+// For this to work, your audio must be in an .OGG file encoded with OPUS (other formats may be sent as Audio or Document).
+// On success, the sent Message is returned. Bots can currently send voice messages of up to 50 MB in size,
+// this limit may be changed in the future.
+func (bot *Bot) SendVoice(ctx context.Context, params *SendVoiceParams) (*Message, error) {
+	return Call[*Message](ctx, bot, "sendVoice", params)
+}
+
+// As of v.4.0, Telegram clients support rounded square MPEG4 videos of up to 1 minute long.
+// Use this method to send video messages. On success, the sent Message is returned.
+func (bot *Bot) SendVideoNote(ctx context.Context, params *SendVideoNoteParams) (*Message, error) {
+	return Call[*Message](ctx, bot, "sendVideoNote", params)
+}
+
+// Use this method to send a group of photos, videos, documents or audios as an album.
+// Documents and audio files can be only grouped in an album with messages of the same type.
+// On success, an array of Messages that were sent is returned.
+// The album is checked before sending, so a request that Telegram would reject is not made.
+func (bot *Bot) SendMediaGroup(ctx context.Context, params *SendMediaGroupParams) ([]Message, error) {
+	if err := validateMediaGroup(params.Media); err != nil {
+		return nil, fmt.Errorf("method sendMediaGroup: %w", err)
+	}
+	return Call[[]Message](ctx, bot, "sendMediaGroup", params)
+}
+
+// Checks that media can be sent as an album
+func validateMediaGroup(media []InputMedia) error {
+	if len(media) < 2 || len(media) > 10 {
+		return fmt.Errorf("album must include 2-10 items, got %d", len(media))
+	}
+	kinds := make(map[string]bool)
+	for i, item := range media {
+		if item == nil {
+			return fmt.Errorf("album item %d is nil", i)
+		}
+		kind := item.inputMediaType()
+		switch kind {
+		case "animation":
+			return errors.New("animations can't be sent in an album")
+		case "photo", "video":
+			// Photos and videos can be mixed with each other
+			kind = "photo or video"
+		}
+		kinds[kind] = true
+	}
+	if len(kinds) > 1 {
+		return errors.New("documents and audio files can be only grouped in an album with messages of the same type")
+	}
+	return nil
+}
+
+// Use this method to send point on the map. On success, the sent Message is returned.
+func (bot *Bot) SendLocation(ctx context.Context, params *SendLocationParams) (*Message, error) {
+	return Call[*Message](ctx, bot, "sendLocation", params)
+}
+
+// Use this method to send information about a venue. On success, the sent Message is returned.
+func (bot *Bot) SendVenue(ctx context.Context, params *SendVenueParams) (*Message, error) {
+	return Call[*Message](ctx, bot, "sendVenue", params)
+}
+
+// Use this method to send phone contacts. On success, the sent Message is returned.
+func (bot *Bot) SendContact(ctx context.Context, params *SendContactParams) (*Message, error) {
+	return Call[*Message](ctx, bot, "sendContact", params)
+}
+
+// Use this method to send a native poll. On success, the sent Message is returned.
+func (bot *Bot) SendPoll(ctx context.Context, params *SendPollParams) (*Message, error) {
+	return Call[*Message](ctx, bot, "sendPoll", params)
+}
+
+// Use this method to send an animated emoji that will display a random value. On success, the sent Message is returned.
+func (bot *Bot) SendDice(ctx context.Context, params *SendDiceParams) (*Message, error) {
+	return Call[*Message](ctx, bot, "sendDice", params)
+}
+
+// Use this method when you need to tell the user that something is happening on the bot's side.
+// The status is set for 5 seconds or less (when a message arrives from your bot,
+// Telegram clients clear its typing status). Returns nil on success.
+func (bot *Bot) SendChatAction(ctx context.Context, params *SendChatActionParams) (err error) {
+	_, err = Call[bool](ctx, bot, "sendChatAction", params)
+	return
+}
+
+// Use this method to ban a user in a group, a supergroup or a channel. In the case of supergroups and channels,
+// the user will not be able to return to the chat on their own using invite links, etc., unless unbanned first.
+// The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
+// Returns nil on success.
+func (bot *Bot) BanChatMember(ctx context.Context, params *BanChatMemberParams) (err error) {
+	_, err = Call[bool](ctx, bot, "banChatMember", params)
+	return
+}
+
+// Use this method to unban a previously banned user in a supergroup or channel. The user will not return
+// to the group or channel automatically, but will be able to join via link, etc. The bot must be an administrator
+// for this to work. By default, this method guarantees that after the call the user is not a member of the chat,
+// but will be able to join it. So if the user is a member of the chat they will also be removed from the chat.
+// If you don't want this, use the parameter OnlyIfBanned. Returns nil on success.
+func (bot *Bot) UnbanChatMember(ctx context.Context, params *UnbanChatMemberParams) (err error) {
+	_, err = Call[bool](ctx, bot, "unbanChatMember", params)
+	return
+}
+
+// Use this method to restrict a user in a supergroup. The bot must be an administrator in the supergroup
+// for this to work and must have the appropriate administrator rights. Pass True for all permissions to lift
+// restrictions from a user. Returns nil on success.
+func (bot *Bot) RestrictChatMember(ctx context.Context, params *RestrictChatMemberParams) (err error) {
+	_, err = Call[bool](ctx, bot, "restrictChatMember", params)
+	return
+}
+
+// Use this method to promote or demote a user in a supergroup or a channel. The bot must be an administrator
+// in the chat for this to work and must have the appropriate administrator rights. Pass False for all boolean
+// parameters to demote a user. Returns nil on success.
+func (bot *Bot) PromoteChatMember(ctx context.Context, params *PromoteChatMemberParams) (err error) {
+	_, err = Call[bool](ctx, bot, "promoteChatMember", params)
+	return
+}
+
+// Use this method to set a custom title for an administrator in a supergroup promoted by the bot.
+// Returns nil on success.
+func (bot *Bot) SetChatAdministratorCustomTitle(ctx context.Context, params *SetChatAdministratorCustomTitleParams) (err error) {
+	_, err = Call[bool](ctx, bot, "setChatAdministratorCustomTitle", params)
+	return
+}
+
+// Use this method to ban a channel chat in a supergroup or a channel. Until the chat is unbanned,
+// the owner of the banned chat won't be able to send messages on behalf of any of their channels.
+// The bot must be an administrator in the supergroup or channel for this to work and must have
+// the appropriate administrator rights. Returns nil on success.
+func (bot *Bot) BanChatSenderChat(ctx context.Context, params *BanChatSenderChatParams) (err error) {
+	_, err = Call[bool](ctx, bot, "banChatSenderChat", params)
+	return
+}
+
+// Use this method to unban a previously banned channel chat in a supergroup or channel.
+// The bot must be an administrator for this to work and must have the appropriate administrator rights.
+// Returns nil on success.
+func (bot *Bot) UnbanChatSenderChat(ctx context.Context, params *UnbanChatSenderChatParams) (err error) {
+	_, err = Call[bool](ctx, bot, "unbanChatSenderChat", params)
+	return
+}
+
+// Use this method to set default chat permissions for all members. The bot must be an administrator
+// in the group or a supergroup for this to work and must have the can_restrict_members administrator rights.
+// Returns nil on success.
+func (bot *Bot) SetChatPermissions(ctx context.Context, params *SetChatPermissionsParams) (err error) {
+	_, err = Call[bool](ctx, bot, "setChatPermissions", params)
+	return
+}
+
+// Use this method to get a list of administrators in a chat, which aren't bots.
+// Returns an array of ChatMember objects. Returns an error if a member has an unknown status,
+// rather than an incomplete list.
+func (bot *Bot) GetChatAdministrators(ctx context.Context, chatId interface{}) ([]ChatMember, error) {
+	results, err := Call[[]json.RawMessage](ctx, bot, "getChatAdministrators", &GetChatParams{ChatId: chatId})
+	if err != nil {
+		return nil, err
+	}
+	administrators := make([]ChatMember, 0, len(results))
+	for _, result := range results {
+		administrator, err := unmarshalChatMember(result)
+		if err != nil {
+			return nil, fmt.Errorf("method getChatAdministrators: decoding result: %w", err)
+		}
+		if !isKnown(administrator) {
+			return nil, errors.New("method getChatAdministrators: unknown chat member status")
+		}
+		administrators = append(administrators, administrator)
+	}
+	return administrators, nil
+}
+
+// Use this method to get the number of members in a chat. Returns Int on success.
+func (bot *Bot) GetChatMemberCount(ctx context.Context, chatId interface{}) (int, error) {
+	return Call[int](ctx, bot, "getChatMemberCount", &GetChatParams{ChatId: chatId})
+}
+
+// Use this method to get information about a member of a chat. The method is only guaranteed to work
+// for other users if the bot is an administrator in the chat. Returns a ChatMember object on success,
+// or an error if the member has an unknown status.
+func (bot *Bot) GetChatMember(ctx context.Context, chatId interface{}, userId int64) (ChatMember, error) {
+	result, err := Call[json.RawMessage](ctx, bot, "getChatMember", &GetChatMemberParams{ChatId: chatId, UserId: userId})
+	if err != nil {
+		return nil, err
+	}
+	member, err := unmarshalChatMember(result)
+	if err != nil {
+		return nil, fmt.Errorf("method getChatMember: decoding result: %w", err)
+	}
+	if !isKnown(member) {
+		return nil, errors.New("method getChatMember: unknown chat member status")
+	}
+	return member, nil
+}
+
+// Use this method to generate a new primary invite link for a chat; any previously generated primary link is revoked.
+// The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
+// Returns the new invite link as String on success.
+func (bot *Bot) ExportChatInviteLink(ctx context.Context, chatId interface{}) (string, error) {
+	return Call[string](ctx, bot, "exportChatInviteLink", &GetChatParams{ChatId: chatId})
+}
+
+// Use this method to create an additional invite link for a chat. The bot must be an administrator in the chat
+// for this to work and must have the appropriate administrator rights. The link can be revoked using the method
+// RevokeChatInviteLink. Returns the new invite link as ChatInviteLink object.
+func (bot *Bot) CreateChatInviteLink(ctx context.Context, params *CreateChatInviteLinkParams) (*ChatInviteLink, error) {
+	return Call[*ChatInviteLink](ctx, bot, "createChatInviteLink", params)
+}
+
+// Use this method to edit a non-primary invite link created by the bot. The bot must be an administrator in the chat
+// for this to work and must have the appropriate administrator rights. Returns the edited invite link as a ChatInviteLink object.
+func (bot *Bot) EditChatInviteLink(ctx context.Context, params *EditChatInviteLinkParams) (*ChatInviteLink, error) {
+	return Call[*ChatInviteLink](ctx, bot, "editChatInviteLink", params)
+}
+
+// Use this method to revoke an invite link created by the bot. If the primary link is revoked, a new link is automatically
+// generated. The bot must be an administrator in the chat for this to work and must have the appropriate administrator rights.
+// Returns the revoked invite link as ChatInviteLink object.
+func (bot *Bot) RevokeChatInviteLink(ctx context.Context, chatId interface{}, inviteLink string) (*ChatInviteLink, error) {
+	return Call[*ChatInviteLink](ctx, bot, "revokeChatInviteLink", &RevokeChatInviteLinkParams{ChatId: chatId, InviteLink: inviteLink})
+}
+
+// Use this method to approve a chat join request. The bot must be an administrator in the chat for this to work
+// and must have the can_invite_users administrator right. Returns nil on success.
+func (bot *Bot) ApproveChatJoinRequest(ctx context.Context, chatId interface{}, userId int64) (err error) {
+	_, err = Call[bool](ctx, bot, "approveChatJoinRequest", &ChatJoinRequestParams{ChatId: chatId, UserId: userId})
+	return
+}
+
+// Use this method to decline a chat join request. The bot must be an administrator in the chat for this to work
+// and must have the can_invite_users administrator right. Returns nil on success.
+func (bot *Bot) DeclineChatJoinRequest(ctx context.Context, chatId interface{}, userId int64) (err error) {
+	_, err = Call[bool](ctx, bot, "declineChatJoinRequest", &ChatJoinRequestParams{ChatId: chatId, UserId: userId})
+	return
+}
+
+// Use this method to get custom emoji stickers, which can be used as a forum topic icon by any user.
+// Requires no parameters. Returns an Array of Sticker objects.
+func (bot *Bot) GetForumTopicIconStickers(ctx context.Context) ([]Sticker, error) {
+	return Call[[]Sticker](ctx, bot, "getForumTopicIconStickers", nil)
+}
+
+// Use this method to create a topic in a forum supergroup chat. The bot must be an administrator in the chat
+// for this to work and must have the can_manage_topics administrator rights.
+// Returns information about the created topic as a ForumTopic object.
+func (bot *Bot) CreateForumTopic(ctx context.Context, params *CreateForumTopicParams) (*ForumTopic, error) {
+	return Call[*ForumTopic](ctx, bot, "createForumTopic", params)
+}
+
+// Use this method to edit name and icon of a topic in a forum supergroup chat. The bot must be an administrator
+// in the chat for this to work and must have can_manage_topics administrator rights, unless it is the creator
+// of the topic. Returns nil on success.
+func (bot *Bot) EditForumTopic(ctx context.Context, params *EditForumTopicParams) (err error) {
+	_, err = Call[bool](ctx, bot, "editForumTopic", params)
+	return
+}
+
+// Use this method to close an open topic in a forum supergroup chat. The bot must be an administrator in the chat
+// for this to work and must have the can_manage_topics administrator rights, unless it is the creator of the topic.
+// Returns nil on success.
+func (bot *Bot) CloseForumTopic(ctx context.Context, chatId interface{}, messageThreadId int) (err error) {
+	_, err = Call[bool](ctx, bot, "closeForumTopic", &ForumTopicParams{ChatId: chatId, MessageThreadId: messageThreadId})
+	return
+}
+
+// Use this method to reopen a closed topic in a forum supergroup chat. The bot must be an administrator in the chat
+// for this to work and must have the can_manage_topics administrator rights, unless it is the creator of the topic.
+// Returns nil on success.
+func (bot *Bot) ReopenForumTopic(ctx context.Context, chatId interface{}, messageThreadId int) (err error) {
+	_, err = Call[bool](ctx, bot, "reopenForumTopic", &ForumTopicParams{ChatId: chatId, MessageThreadId: messageThreadId})
+	return
+}
+
+// Use this method to delete a forum topic along with all its messages in a forum supergroup chat.
+// The bot must be an administrator in the chat for this to work and must have the can_delete_messages
+// administrator rights. Returns nil on success.
+func (bot *Bot) DeleteForumTopic(ctx context.Context, chatId interface{}, messageThreadId int) (err error) {
+	_, err = Call[bool](ctx, bot, "deleteForumTopic", &ForumTopicParams{ChatId: chatId, MessageThreadId: messageThreadId})
+	return
+}
+
+// Use this method to clear the list of pinned messages in a forum topic. The bot must be an administrator
+// in the chat for this to work and must have the can_pin_messages administrator right in the supergroup.
+// Returns nil on success.
+func (bot *Bot) UnpinAllForumTopicMessages(ctx context.Context, chatId interface{}, messageThreadId int) (err error) {
+	_, err = Call[bool](ctx, bot, "unpinAllForumTopicMessages", &ForumTopicParams{ChatId: chatId, MessageThreadId: messageThreadId})
+	return
+}
+
+// Use this method to edit the name of the 'General' topic in a forum supergroup chat. The bot must be
+// an administrator in the chat for this to work and must have can_manage_topics administrator rights.
+// Returns nil on success.
+func (bot *Bot) EditGeneralForumTopic(ctx context.Context, chatId interface{}, name string) (err error) {
+	_, err = Call[bool](ctx, bot, "editGeneralForumTopic", &EditGeneralForumTopicParams{ChatId: chatId, Name: name})
+	return
+}
+
+// Use this method to close an open 'General' topic in a forum supergroup chat. The bot must be an administrator
+// in the chat for this to work and must have the can_manage_topics administrator rights. Returns nil on success.
+func (bot *Bot) CloseGeneralForumTopic(ctx context.Context, chatId interface{}) (err error) {
+	_, err = Call[bool](ctx, bot, "closeGeneralForumTopic", &GetChatParams{ChatId: chatId})
+	return
+}
+
+// Use this method to reopen a closed 'General' topic in a forum supergroup chat. The bot must be an administrator
+// in the chat for this to work and must have the can_manage_topics administrator rights. The topic will be
+// automatically unhidden if it was hidden. Returns nil on success.
+func (bot *Bot) ReopenGeneralForumTopic(ctx context.Context, chatId interface{}) (err error) {
+	_, err = Call[bool](ctx, bot, "reopenGeneralForumTopic", &GetChatParams{ChatId: chatId})
+	return
+}
+
+// Use this method to hide the 'General' topic in a forum supergroup chat. The bot must be an administrator
+// in the chat for this to work and must have the can_manage_topics administrator rights. The topic will be
+// automatically closed if it was open. Returns nil on success.
+func (bot *Bot) HideGeneralForumTopic(ctx context.Context, chatId interface{}) (err error) {
+	_, err = Call[bool](ctx, bot, "hideGeneralForumTopic", &GetChatParams{ChatId: chatId})
+	return
+}
+
+// Use this method to unhide the 'General' topic in a forum supergroup chat. The bot must be an administrator
+// in the chat for this to work and must have the can_manage_topics administrator rights. Returns nil on success.
+func (bot *Bot) UnhideGeneralForumTopic(ctx context.Context, chatId interface{}) (err error) {
+	_, err = Call[bool](ctx, bot, "unhideGeneralForumTopic", &GetChatParams{ChatId: chatId})
+	return
+}
+
+// Use this method to clear the list of pinned messages in a General forum topic. The bot must be an administrator
+// in the chat for this to work and must have the can_pin_messages administrator right in the supergroup.
+// Returns nil on success.
+func (bot *Bot) UnpinAllGeneralForumTopicMessages(ctx context.Context, chatId interface{}) (err error) {
+	_, err = Call[bool](ctx, bot, "unpinAllGeneralForumTopicMessages", &GetChatParams{ChatId: chatId})
+	return
+}
+
+// Use this method to change the list of the bot's commands. See this manual for more details about bot commands.
+// Returns nil on success.
+func (bot *Bot) SetMyCommands(ctx context.Context, params *SetMyCommandsParams) (err error) {
+	_, err = Call[bool](ctx, bot, "setMyCommands", params)
+	return
+}
+
+// Use this method to delete the list of the bot's commands for the given scope and user language.
+// After deletion, higher level commands will be shown to affected users. Returns nil on success.
+func (bot *Bot) DeleteMyCommands(ctx context.Context, params *DeleteMyCommandsParams) (err error) {
+	_, err = Call[bool](ctx, bot, "deleteMyCommands", params)
+	return
+}
+
+// Use this method to get the current list of the bot's commands for the given scope and user language.
+// Returns an Array of BotCommand objects. If commands aren't set, an empty list is returned.
+func (bot *Bot) GetMyCommands(ctx context.Context, params *GetMyCommandsParams) ([]BotCommand, error) {
+	return Call[[]BotCommand](ctx, bot, "getMyCommands", params)
+}
+
+// Use this method to change the bot's name. Returns nil on success.
+func (bot *Bot) SetMyName(ctx context.Context, params *SetMyNameParams) (err error) {
+	_, err = Call[bool](ctx, bot, "setMyName", params)
+	return
+}
+
+// Use this method to get the current bot name for the given user language. Returns BotName on success.
+func (bot *Bot) GetMyName(ctx context.Context, languageCode string) (*BotName, error) {
+	return Call[*BotName](ctx, bot, "getMyName", &LanguageCodeParams{LanguageCode: languageCode})
+}
+
+// Use this method to change the bot's description, which is shown in the chat with the bot if the chat is empty.
+// Returns nil on success.
+func (bot *Bot) SetMyDescription(ctx context.Context, params *SetMyDescriptionParams) (err error) {
+	_, err = Call[bool](ctx, bot, "setMyDescription", params)
+	return
+}
+
+// Use this method to get the current bot description for the given user language. Returns BotDescription on success.
+func (bot *Bot) GetMyDescription(ctx context.Context, languageCode string) (*BotDescription, error) {
+	return Call[*BotDescription](ctx, bot, "getMyDescription", &LanguageCodeParams{LanguageCode: languageCode})
+}
+
+// Use this method to change the bot's short description, which is shown on the bot's profile page
+// and is sent together with the link when users share the bot. Returns nil on success.
+func (bot *Bot) SetMyShortDescription(ctx context.Context, params *SetMyShortDescriptionParams) (err error) {
+	_, err = Call[bool](ctx, bot, "setMyShortDescription", params)
+	return
+}
+
+// Use this method to get the current bot short description for the given user language.
+// Returns BotShortDescription on success.
+func (bot *Bot) GetMyShortDescription(ctx context.Context, languageCode string) (*BotShortDescription, error) {
+	return Call[*BotShortDescription](ctx, bot, "getMyShortDescription", &LanguageCodeParams{LanguageCode: languageCode})
+}
+
+// Use this method to change the bot's menu button in a private chat, or the default menu button.
+// Returns nil on success.
+func (bot *Bot) SetChatMenuButton(ctx context.Context, params *SetChatMenuButtonParams) (err error) {
+	_, err = Call[bool](ctx, bot, "setChatMenuButton", params)
+	return
+}
+
+// Use this method to get the current value of the bot's menu button in a private chat, or the default menu button
+// if chatId is 0. Returns MenuButton on success.
+func (bot *Bot) GetChatMenuButton(ctx context.Context, chatId int64) (MenuButton, error) {
+	result, err := Call[json.RawMessage](ctx, bot, "getChatMenuButton", &GetChatMenuButtonParams{ChatId: chatId})
+	if err != nil {
+		return nil, err
+	}
+	button, err := unmarshalMenuButton(result)
+	if err != nil {
+		return nil, fmt.Errorf("method getChatMenuButton: decoding result: %w", err)
+	}
+	return button, nil
+}
+
+// Use this method to change the default administrator rights requested by the bot when it's added
+// as an administrator to groups or channels. These rights will be suggested to users,
+// but they are free to modify the list before adding the bot. Returns nil on success.
+func (bot *Bot) SetMyDefaultAdministratorRights(ctx context.Context, params *SetMyDefaultAdministratorRightsParams) (err error) {
+	_, err = Call[bool](ctx, bot, "setMyDefaultAdministratorRights", params)
+	return
+}
+
+// Use this method to get the current default administrator rights of the bot.
+// Returns ChatAdministratorRights on success.
+func (bot *Bot) GetMyDefaultAdministratorRights(ctx context.Context, forChannels bool) (*ChatAdministratorRights, error) {
+	return Call[*ChatAdministratorRights](ctx, bot, "getMyDefaultAdministratorRights", &GetMyDefaultAdministratorRightsParams{ForChannels: forChannels})
+}
@@ -0,0 +1,49 @@
+package gogram
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// Returns a bot whose requests are all answered with the given JSON result
+func botWithResult(t *testing.T, result string) *Bot {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ok":true,"result":` + result + `}`))
+	}))
+	t.Cleanup(server.Close)
+	return newTestBot(t, server.URL)
+}
+
+func TestGetChatAdministrators(t *testing.T) {
+	bot := botWithResult(t, `[
+		{"status":"creator","user":{"id":1},"is_anonymous":false},
+		{"status":"administrator","user":{"id":2},"can_be_edited":true,"can_restrict_members":true}
+	]`)
+	administrators, err := bot.GetChatAdministrators(context.Background(), int64(-100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(administrators) != 2 {
+		t.Fatalf("got %d administrators, want 2", len(administrators))
+	}
+	if _, ok := administrators[0].(*ChatMemberOwner); !ok {
+		t.Errorf("first administrator is %T, want *ChatMemberOwner", administrators[0])
+	}
+	if admin, ok := administrators[1].(*ChatMemberAdministrator); !ok || !admin.CanRestrictMembers {
+		t.Errorf("second administrator is %+v, want *ChatMemberAdministrator which can restrict members", administrators[1])
+	}
+}
+
+func TestUnknownChatMemberStatus(t *testing.T) {
+	bot := botWithResult(t, `[{"status":"creator","user":{"id":1}},{"status":"overlord","user":{"id":2}}]`)
+	if administrators, err := bot.GetChatAdministrators(context.Background(), int64(-100)); err == nil {
+		t.Errorf("GetChatAdministrators() = %v, want an error for the unknown status", administrators)
+	}
+
+	bot = botWithResult(t, `{"status":"overlord","user":{"id":2}}`)
+	if member, err := bot.GetChatMember(context.Background(), int64(-100), 2); err == nil {
+		t.Errorf("GetChatMember() = %v, want an error for the unknown status", member)
+	}
+}
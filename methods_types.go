@@ -0,0 +1,1159 @@
+package gogram
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Type of action passed to sendChatAction
+const (
+	ChatActionTyping          = "typing"
+	ChatActionUploadPhoto     = "upload_photo"
+	ChatActionRecordVideo     = "record_video"
+	ChatActionUploadVideo     = "upload_video"
+	ChatActionRecordVoice     = "record_voice"
+	ChatActionUploadVoice     = "upload_voice"
+	ChatActionUploadDocument  = "upload_document"
+	ChatActionChooseSticker   = "choose_sticker"
+	ChatActionFindLocation    = "find_location"
+	ChatActionRecordVideoNote = "record_video_note"
+	ChatActionUploadVideoNote = "upload_video_note"
+)
+
+// Color of the forum topic icon which can be passed to createForumTopic
+const (
+	ForumTopicIconColorBlue   = 0x6FB9F0
+	ForumTopicIconColorYellow = 0xFFD67E
+	ForumTopicIconColorViolet = 0xCB86DB
+	ForumTopicIconColorGreen  = 0x8EEE98
+	ForumTopicIconColorRose   = 0xFF93B2
+	ForumTopicIconColorRed    = 0xFB6F5F
+)
+
+// Mode for parsing entities in the message text or caption
+const (
+	ParseModeMarkdownV2 = "MarkdownV2"
+	ParseModeHTML       = "HTML"
+	ParseModeMarkdown   = "Markdown"
+)
+
+type SendMessageParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId int `json:"message_thread_id,omitempty"`
+
+	// Text of the message to be sent, 1-4096 characters after entities parsing
+	Text string `json:"text"`
+
+	// Optional. Mode for parsing entities in the message text. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+
+	// Optional. A JSON-serialized list of special entities that appear in message text,
+	// which can be specified instead of parse_mode
+	Entities []MessageEntity `json:"entities,omitempty"`
+
+	// Optional. Disables link previews for links in this message
+	DisableWebPagePreview bool `json:"disable_web_page_preview,omitempty"`
+
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId int `json:"reply_to_message_id,omitempty"`
+
+	// Optional. Pass True if the message should be sent even if the specified replied-to message is not found
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// Optional. Additional interface options. InlineKeyboardMarkup, ReplyKeyboardMarkup,
+	// ReplyKeyboardRemove or ForceReply
+	ReplyMarkup interface{} `json:"reply_markup,omitempty"`
+}
+
+type ForwardMessageParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId int `json:"message_thread_id,omitempty"`
+
+	// Unique identifier for the chat where the original message was sent
+	// (or channel username in the format @channelusername)
+	FromChatId interface{} `json:"from_chat_id"`
+
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Optional. Protects the contents of the forwarded message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Message identifier in the chat specified in from_chat_id
+	MessageId int `json:"message_id"`
+}
+
+type CopyMessageParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId int `json:"message_thread_id,omitempty"`
+
+	// Unique identifier for the chat where the original message was sent
+	// (or channel username in the format @channelusername)
+	FromChatId interface{} `json:"from_chat_id"`
+
+	// Message identifier in the chat specified in from_chat_id
+	MessageId int `json:"message_id"`
+
+	// Optional. New caption for media, 0-1024 characters after entities parsing.
+	// If not specified, the original caption is kept
+	Caption *string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the new caption. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+
+	// Optional. A JSON-serialized list of special entities that appear in the new caption,
+	// which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId int `json:"reply_to_message_id,omitempty"`
+
+	// Optional. Pass True if the message should be sent even if the specified replied-to message is not found
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// Optional. Additional interface options. InlineKeyboardMarkup, ReplyKeyboardMarkup,
+	// ReplyKeyboardRemove or ForceReply
+	ReplyMarkup interface{} `json:"reply_markup,omitempty"`
+}
+
+type SendLocationParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId int `json:"message_thread_id,omitempty"`
+
+	// Latitude of the location
+	Latitude float64 `json:"latitude"`
+
+	// Longitude of the location
+	Longitude float64 `json:"longitude"`
+
+	// Optional. The radius of uncertainty for the location, measured in meters; 0-1500
+	HorizontalAccuracy float64 `json:"horizontal_accuracy,omitempty"`
+
+	// Optional. Period in seconds for which the location will be updated (see Live Locations), should be between 60 and 86400.
+	LivePeriod int `json:"live_period,omitempty"`
+
+	// Optional. For live locations, a direction in which the user is moving, in degrees. Must be between 1 and 360 if specified.
+	Heading int `json:"heading,omitempty"`
+
+	// Optional. For live locations, a maximum distance for proximity alerts about approaching another chat member,
+	// in meters. Must be between 1 and 100000 if specified.
+	ProximityAlertRadius int `json:"proximity_alert_radius,omitempty"`
+
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId int `json:"reply_to_message_id,omitempty"`
+
+	// Optional. Pass True if the message should be sent even if the specified replied-to message is not found
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// Optional. Additional interface options. InlineKeyboardMarkup, ReplyKeyboardMarkup,
+	// ReplyKeyboardRemove or ForceReply
+	ReplyMarkup interface{} `json:"reply_markup,omitempty"`
+}
+
+type SendVenueParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId int `json:"message_thread_id,omitempty"`
+
+	// Latitude of the venue
+	Latitude float64 `json:"latitude"`
+
+	// Longitude of the venue
+	Longitude float64 `json:"longitude"`
+
+	// Name of the venue
+	Title string `json:"title"`
+
+	// Address of the venue
+	Address string `json:"address"`
+
+	// Optional. Foursquare identifier of the venue
+	FoursquareId string `json:"foursquare_id,omitempty"`
+
+	// Optional. Foursquare type of the venue, if known.
+	// (For example, “arts_entertainment/default”, “arts_entertainment/aquarium” or “food/icecream”.)
+	FoursquareType string `json:"foursquare_type,omitempty"`
+
+	// Optional. Google Places identifier of the venue
+	GooglePlaceId string `json:"google_place_id,omitempty"`
+
+	// Optional. Google Places type of the venue
+	GooglePlaceType string `json:"google_place_type,omitempty"`
+
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId int `json:"reply_to_message_id,omitempty"`
+
+	// Optional. Pass True if the message should be sent even if the specified replied-to message is not found
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// Optional. Additional interface options. InlineKeyboardMarkup, ReplyKeyboardMarkup,
+	// ReplyKeyboardRemove or ForceReply
+	ReplyMarkup interface{} `json:"reply_markup,omitempty"`
+}
+
+type SendContactParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId int `json:"message_thread_id,omitempty"`
+
+	// Contact's phone number
+	PhoneNumber string `json:"phone_number"`
+
+	// Contact's first name
+	FirstName string `json:"first_name"`
+
+	// Optional. Contact's last name
+	LastName string `json:"last_name,omitempty"`
+
+	// Optional. Additional data about the contact in the form of a vCard, 0-2048 bytes
+	VCard string `json:"vcard,omitempty"`
+
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId int `json:"reply_to_message_id,omitempty"`
+
+	// Optional. Pass True if the message should be sent even if the specified replied-to message is not found
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// Optional. Additional interface options. InlineKeyboardMarkup, ReplyKeyboardMarkup,
+	// ReplyKeyboardRemove or ForceReply
+	ReplyMarkup interface{} `json:"reply_markup,omitempty"`
+}
+
+type SendPollParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId int `json:"message_thread_id,omitempty"`
+
+	// Poll question, 1-300 characters
+	Question string `json:"question"`
+
+	// A JSON-serialized list of answer options, 2-10 strings 1-100 characters each
+	Options []string `json:"options"`
+
+	// Optional. True, if the poll needs to be anonymous, defaults to True
+	IsAnonymous *bool `json:"is_anonymous,omitempty"`
+
+	// Optional. Poll type, “quiz” or “regular”, defaults to “regular”
+	Type string `json:"type,omitempty"`
+
+	// Optional. True, if the poll allows multiple answers, ignored for polls in quiz mode, defaults to False
+	AllowsMultipleAnswers bool `json:"allows_multiple_answers,omitempty"`
+
+	// Optional. 0-based identifier of the correct answer option, required for polls in quiz mode
+	CorrectOptionId *int `json:"correct_option_id,omitempty"`
+
+	// Optional. Text that is shown when a user chooses an incorrect answer or taps on the lamp icon
+	// in a quiz-style poll, 0-200 characters with at most 2 line feeds after entities parsing
+	Explanation string `json:"explanation,omitempty"`
+
+	// Optional. Mode for parsing entities in the explanation. See formatting options for more details.
+	ExplanationParseMode string `json:"explanation_parse_mode,omitempty"`
+
+	// Optional. A JSON-serialized list of special entities that appear in the poll explanation,
+	// which can be specified instead of parse_mode
+	ExplanationEntities []MessageEntity `json:"explanation_entities,omitempty"`
+
+	// Optional. Amount of time in seconds the poll will be active after creation, 5-600. Can't be used together with close_date.
+	OpenPeriod int `json:"open_period,omitempty"`
+
+	// Optional. Point in time (Unix timestamp) when the poll will be automatically closed.
+	// Must be at least 5 and no more than 600 seconds in the future. Can't be used together with open_period.
+	CloseDate int `json:"close_date,omitempty"`
+
+	// Optional. Pass True if the poll needs to be immediately closed. This can be useful for poll preview.
+	IsClosed bool `json:"is_closed,omitempty"`
+
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId int `json:"reply_to_message_id,omitempty"`
+
+	// Optional. Pass True if the message should be sent even if the specified replied-to message is not found
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// Optional. Additional interface options. InlineKeyboardMarkup, ReplyKeyboardMarkup,
+	// ReplyKeyboardRemove or ForceReply
+	ReplyMarkup interface{} `json:"reply_markup,omitempty"`
+}
+
+type SendDiceParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId int `json:"message_thread_id,omitempty"`
+
+	// Optional. Emoji on which the dice throw animation is based. Currently, must be one of
+	// “🎲”, “🎯”, “🏀”, “⚽”, “🎳”, or “🎰”. Dice can have values 1-6 for “🎲”, “🎯” and “🎳”,
+	// values 1-5 for “🏀” and “⚽”, and values 1-64 for “🎰”. Defaults to “🎲”
+	Emoji string `json:"emoji,omitempty"`
+
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Optional. Protects the contents of the sent message from forwarding
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId int `json:"reply_to_message_id,omitempty"`
+
+	// Optional. Pass True if the message should be sent even if the specified replied-to message is not found
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// Optional. Additional interface options. InlineKeyboardMarkup, ReplyKeyboardMarkup,
+	// ReplyKeyboardRemove or ForceReply
+	ReplyMarkup interface{} `json:"reply_markup,omitempty"`
+}
+
+type SendChatActionParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId int `json:"message_thread_id,omitempty"`
+
+	// Type of action to broadcast, one of the ChatAction constants
+	Action string `json:"action"`
+}
+
+type SendPhotoParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId int `json:"message_thread_id,omitempty"`
+
+	// Photo to send. Pass a file_id as String to send a photo that exists on the Telegram servers (recommended),
+	// pass an HTTP URL as a String for Telegram to get a photo from the Internet,
+	// or upload a new photo using multipart/form-data. The photo must be at most 10 MB in size.
+	// The photo's width and height must not exceed 10000 in total. Width and height ratio must be at most 20.
+	Photo *InputFile `json:"photo"`
+
+	// Optional. Photo caption (may also be used when resending photos by file_id), 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the photo caption. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+
+	// Optional. A JSON-serialized list of special entities that appear in the caption,
+	// which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Pass True if the photo needs to be covered with a spoiler animation
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId int `json:"reply_to_message_id,omitempty"`
+
+	// Optional. Pass True if the message should be sent even if the specified replied-to message is not found
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// Optional. Additional interface options. InlineKeyboardMarkup, ReplyKeyboardMarkup,
+	// ReplyKeyboardRemove or ForceReply
+	ReplyMarkup interface{} `json:"reply_markup,omitempty"`
+}
+
+type SendAudioParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId int `json:"message_thread_id,omitempty"`
+
+	// Audio file to send. Pass a file_id as String to send an audio file that exists on the Telegram servers (recommended),
+	// pass an HTTP URL as a String for Telegram to get an audio file from the Internet,
+	// or upload a new audio file using multipart/form-data.
+	Audio *InputFile `json:"audio"`
+
+	// Optional. Audio caption (may also be used when resending audios by file_id), 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the audio caption. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+
+	// Optional. A JSON-serialized list of special entities that appear in the caption,
+	// which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Duration of the audio in seconds
+	Duration int `json:"duration,omitempty"`
+
+	// Optional. Performer
+	Performer string `json:"performer,omitempty"`
+
+	// Optional. Track name
+	Title string `json:"title,omitempty"`
+
+	// Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side.
+	// The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320.
+	// Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused
+	// and can be only uploaded as a new file
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
+
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId int `json:"reply_to_message_id,omitempty"`
+
+	// Optional. Pass True if the message should be sent even if the specified replied-to message is not found
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// Optional. Additional interface options. InlineKeyboardMarkup, ReplyKeyboardMarkup,
+	// ReplyKeyboardRemove or ForceReply
+	ReplyMarkup interface{} `json:"reply_markup,omitempty"`
+}
+
+type SendDocumentParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId int `json:"message_thread_id,omitempty"`
+
+	// File to send. Pass a file_id as String to send a file that exists on the Telegram servers (recommended),
+	// pass an HTTP URL as a String for Telegram to get a file from the Internet,
+	// or upload a new file using multipart/form-data.
+	Document *InputFile `json:"document"`
+
+	// Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side.
+	// The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320.
+	// Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused
+	// and can be only uploaded as a new file
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
+
+	// Optional. Document caption (may also be used when resending documents by file_id), 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the document caption. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+
+	// Optional. A JSON-serialized list of special entities that appear in the caption,
+	// which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Disables automatic server-side content type detection for files uploaded using multipart/form-data
+	DisableContentTypeDetection bool `json:"disable_content_type_detection,omitempty"`
+
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId int `json:"reply_to_message_id,omitempty"`
+
+	// Optional. Pass True if the message should be sent even if the specified replied-to message is not found
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// Optional. Additional interface options. InlineKeyboardMarkup, ReplyKeyboardMarkup,
+	// ReplyKeyboardRemove or ForceReply
+	ReplyMarkup interface{} `json:"reply_markup,omitempty"`
+}
+
+type SendVideoParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId int `json:"message_thread_id,omitempty"`
+
+	// Video to send. Pass a file_id as String to send a video that exists on the Telegram servers (recommended),
+	// pass an HTTP URL as a String for Telegram to get a video from the Internet,
+	// or upload a new video using multipart/form-data.
+	Video *InputFile `json:"video"`
+
+	// Optional. Duration of the sent video in seconds
+	Duration int `json:"duration,omitempty"`
+
+	// Optional. Video width
+	Width int `json:"width,omitempty"`
+
+	// Optional. Video height
+	Height int `json:"height,omitempty"`
+
+	// Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side.
+	// The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320.
+	// Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused
+	// and can be only uploaded as a new file
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
+
+	// Optional. Video caption (may also be used when resending videos by file_id), 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the video caption. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+
+	// Optional. A JSON-serialized list of special entities that appear in the caption,
+	// which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Pass True if the video needs to be covered with a spoiler animation
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+
+	// Optional. Pass True if the uploaded video is suitable for streaming
+	SupportsStreaming bool `json:"supports_streaming,omitempty"`
+
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId int `json:"reply_to_message_id,omitempty"`
+
+	// Optional. Pass True if the message should be sent even if the specified replied-to message is not found
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// Optional. Additional interface options. InlineKeyboardMarkup, ReplyKeyboardMarkup,
+	// ReplyKeyboardRemove or ForceReply
+	ReplyMarkup interface{} `json:"reply_markup,omitempty"`
+}
+
+type SendAnimationParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId int `json:"message_thread_id,omitempty"`
+
+	// Animation to send. Pass a file_id as String to send an animation that exists on the Telegram servers (recommended),
+	// pass an HTTP URL as a String for Telegram to get an animation from the Internet,
+	// or upload a new animation using multipart/form-data.
+	Animation *InputFile `json:"animation"`
+
+	// Optional. Duration of the sent animation in seconds
+	Duration int `json:"duration,omitempty"`
+
+	// Optional. Animation width
+	Width int `json:"width,omitempty"`
+
+	// Optional. Animation height
+	Height int `json:"height,omitempty"`
+
+	// Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side.
+	// The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320.
+	// Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused
+	// and can be only uploaded as a new file
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
+
+	// Optional. Animation caption (may also be used when resending animations by file_id), 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the animation caption. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+
+	// Optional. A JSON-serialized list of special entities that appear in the caption,
+	// which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Pass True if the animation needs to be covered with a spoiler animation
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
+
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId int `json:"reply_to_message_id,omitempty"`
+
+	// Optional. Pass True if the message should be sent even if the specified replied-to message is not found
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// Optional. Additional interface options. InlineKeyboardMarkup, ReplyKeyboardMarkup,
+	// ReplyKeyboardRemove or ForceReply
+	ReplyMarkup interface{} `json:"reply_markup,omitempty"`
+}
+
+type SendVoiceParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId int `json:"message_thread_id,omitempty"`
+
+	// Audio file to send. Pass a file_id as String to send an audio file that exists on the Telegram servers (recommended),
+	// pass an HTTP URL as a String for Telegram to get an audio file from the Internet,
+	// or upload a new audio file using multipart/form-data. The audio must be in an .OGG file encoded with OPUS
+	Voice *InputFile `json:"voice"`
+
+	// Optional. Voice message caption (may also be used when resending voice messages by file_id), 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the voice message caption. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+
+	// Optional. A JSON-serialized list of special entities that appear in the caption,
+	// which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. Duration of the voice message in seconds
+	Duration int `json:"duration,omitempty"`
+
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId int `json:"reply_to_message_id,omitempty"`
+
+	// Optional. Pass True if the message should be sent even if the specified replied-to message is not found
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// Optional. Additional interface options. InlineKeyboardMarkup, ReplyKeyboardMarkup,
+	// ReplyKeyboardRemove or ForceReply
+	ReplyMarkup interface{} `json:"reply_markup,omitempty"`
+}
+
+type SendVideoNoteParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId int `json:"message_thread_id,omitempty"`
+
+	// Video note to send. Pass a file_id as String to send a video note that exists on the Telegram servers (recommended)
+	// or upload a new video using multipart/form-data. Sending video notes by a URL is currently unsupported
+	VideoNote *InputFile `json:"video_note"`
+
+	// Optional. Duration of the sent video in seconds
+	Duration int `json:"duration,omitempty"`
+
+	// Optional. Video width and height, i.e. diameter of the video message
+	Length int `json:"length,omitempty"`
+
+	// Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side.
+	// The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail's width and height should not exceed 320.
+	// Ignored if the file is not uploaded using multipart/form-data. Thumbnails can't be reused
+	// and can be only uploaded as a new file
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
+
+	// Optional. Sends the message silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Optional. Protects the contents of the sent message from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Optional. If the message is a reply, ID of the original message
+	ReplyToMessageId int `json:"reply_to_message_id,omitempty"`
+
+	// Optional. Pass True if the message should be sent even if the specified replied-to message is not found
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+
+	// Optional. Additional interface options. InlineKeyboardMarkup, ReplyKeyboardMarkup,
+	// ReplyKeyboardRemove or ForceReply
+	ReplyMarkup interface{} `json:"reply_markup,omitempty"`
+}
+
+type SendMediaGroupParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Optional. Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
+	MessageThreadId int `json:"message_thread_id,omitempty"`
+
+	// A JSON-serialized array describing messages to be sent, must include 2-10 items.
+	// Photos and videos can be mixed, documents and audio files can be only grouped
+	// with messages of the same type. Animations can't be sent in an album
+	Media []InputMedia `json:"media"`
+
+	// Optional. Sends messages silently. Users will receive a notification with no sound.
+	DisableNotification bool `json:"disable_notification,omitempty"`
+
+	// Optional. Protects the contents of the sent messages from forwarding and saving
+	ProtectContent bool `json:"protect_content,omitempty"`
+
+	// Optional. If the messages are a reply, ID of the original message
+	ReplyToMessageId int `json:"reply_to_message_id,omitempty"`
+
+	// Optional. Pass True if the message should be sent even if the specified replied-to message is not found
+	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
+}
+
+type GetFileParams struct {
+	// File identifier to get information about
+	FileId string `json:"file_id"`
+}
+
+type BanChatMemberParams struct {
+	// Unique identifier for the target group or username of the target supergroup or channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Unique identifier of the target user
+	UserId int64 `json:"user_id"`
+
+	// Optional. Date when the user will be unbanned. If user is banned for more than 366 days
+	// or less than 30 seconds from the current time they are considered to be banned forever.
+	// Applied for supergroups and channels only.
+	UntilDate time.Time `json:"-"`
+
+	// Optional. How long the user is banned for, used when UntilDate is not set
+	Duration time.Duration `json:"-"`
+
+	// Optional. Pass True to delete all messages from the chat for the user that is being removed.
+	// If False, the user will be able to see messages in the group that were sent before the user was removed.
+	// Always True for supergroups and channels.
+	RevokeMessages bool `json:"revoke_messages,omitempty"`
+}
+
+func (params BanChatMemberParams) MarshalJSON() ([]byte, error) {
+	type banChatMemberParams BanChatMemberParams
+	return json.Marshal(struct {
+		banChatMemberParams
+		UntilDate int64 `json:"until_date,omitempty"`
+	}{banChatMemberParams(params), untilDate(params.UntilDate, params.Duration)})
+}
+
+type UnbanChatMemberParams struct {
+	// Unique identifier for the target group or username of the target supergroup or channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Unique identifier of the target user
+	UserId int64 `json:"user_id"`
+
+	// Optional. Do nothing if the user is not banned
+	OnlyIfBanned bool `json:"only_if_banned,omitempty"`
+}
+
+type RestrictChatMemberParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Unique identifier of the target user
+	UserId int64 `json:"user_id"`
+
+	// New user permissions
+	Permissions *ChatPermissions `json:"permissions"`
+
+	// Optional. Pass True if chat permissions are set independently. Otherwise, the can_send_other_messages
+	// and can_add_web_page_previews permissions will imply the can_send_messages, can_send_audios,
+	// can_send_documents, can_send_photos, can_send_videos, can_send_video_notes, and can_send_voice_notes
+	// permissions; the can_send_polls permission will imply the can_send_messages permission.
+	UseIndependentChatPermissions bool `json:"use_independent_chat_permissions,omitempty"`
+
+	// Optional. Date when restrictions will be lifted for the user. If user is restricted for more than
+	// 366 days or less than 30 seconds from the current time, they are considered to be restricted forever
+	UntilDate time.Time `json:"-"`
+
+	// Optional. How long the user is restricted for, used when UntilDate is not set
+	Duration time.Duration `json:"-"`
+}
+
+func (params RestrictChatMemberParams) MarshalJSON() ([]byte, error) {
+	type restrictChatMemberParams RestrictChatMemberParams
+	return json.Marshal(struct {
+		restrictChatMemberParams
+		UntilDate int64 `json:"until_date,omitempty"`
+	}{restrictChatMemberParams(params), untilDate(params.UntilDate, params.Duration)})
+}
+
+// Returns the given date, or the date the duration from now, in Unix time. Zero means no date
+func untilDate(date time.Time, duration time.Duration) int64 {
+	switch {
+	case !date.IsZero():
+		return date.Unix()
+	case duration > 0:
+		return time.Now().Add(duration).Unix()
+	}
+	return 0
+}
+
+type PromoteChatMemberParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Unique identifier of the target user
+	UserId int64 `json:"user_id"`
+
+	// Optional. Pass True if the administrator's presence in the chat is hidden
+	IsAnonymous bool `json:"is_anonymous,omitempty"`
+
+	// Optional. Pass True if the administrator can access the chat event log, chat statistics,
+	// message statistics in channels, see channel members, see anonymous administrators in supergroups
+	// and ignore slow mode. Implied by any other administrator privilege
+	CanManageChat bool `json:"can_manage_chat,omitempty"`
+
+	// Optional. Pass True if the administrator can create channel posts, channels only
+	CanPostMessages bool `json:"can_post_messages,omitempty"`
+
+	// Optional. Pass True if the administrator can edit messages of other users and can pin messages, channels only
+	CanEditMessages bool `json:"can_edit_messages,omitempty"`
+
+	// Optional. Pass True if the administrator can delete messages of other users
+	CanDeleteMessages bool `json:"can_delete_messages,omitempty"`
+
+	// Optional. Pass True if the administrator can manage video chats
+	CanManageVideoChats bool `json:"can_manage_video_chats,omitempty"`
+
+	// Optional. Pass True if the administrator can restrict, ban or unban chat members
+	CanRestrictMembers bool `json:"can_restrict_members,omitempty"`
+
+	// Optional. Pass True if the administrator can add new administrators with a subset of their own privileges
+	// or demote administrators that they have promoted, directly or indirectly
+	// (promoted by administrators that were appointed by him)
+	CanPromoteMembers bool `json:"can_promote_members,omitempty"`
+
+	// Optional. Pass True if the administrator can change chat title, photo and other settings
+	CanChangeInfo bool `json:"can_change_info,omitempty"`
+
+	// Optional. Pass True if the administrator can invite new users to the chat
+	CanInviteUsers bool `json:"can_invite_users,omitempty"`
+
+	// Optional. Pass True if the administrator can pin messages, supergroups only
+	CanPinMessages bool `json:"can_pin_messages,omitempty"`
+
+	// Optional. Pass True if the user is allowed to create, rename, close, and reopen forum topics, supergroups only
+	CanManageTopics bool `json:"can_manage_topics,omitempty"`
+}
+
+type SetChatAdministratorCustomTitleParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Unique identifier of the target user
+	UserId int64 `json:"user_id"`
+
+	// New custom title for the administrator; 0-16 characters, emoji are not allowed
+	CustomTitle string `json:"custom_title"`
+}
+
+type BanChatSenderChatParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Unique identifier of the target sender chat
+	SenderChatId int64 `json:"sender_chat_id"`
+}
+
+type UnbanChatSenderChatParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Unique identifier of the target sender chat
+	SenderChatId int64 `json:"sender_chat_id"`
+}
+
+type SetChatPermissionsParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// New default chat permissions
+	Permissions *ChatPermissions `json:"permissions"`
+
+	// Optional. Pass True if chat permissions are set independently. Otherwise, the can_send_other_messages
+	// and can_add_web_page_previews permissions will imply the can_send_messages, can_send_audios,
+	// can_send_documents, can_send_photos, can_send_videos, can_send_video_notes, and can_send_voice_notes
+	// permissions; the can_send_polls permission will imply the can_send_messages permission.
+	UseIndependentChatPermissions bool `json:"use_independent_chat_permissions,omitempty"`
+}
+
+type GetChatParams struct {
+	// Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+}
+
+type GetChatMemberParams struct {
+	// Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Unique identifier of the target user
+	UserId int64 `json:"user_id"`
+}
+
+type CreateChatInviteLinkParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Optional. Invite link name; 0-32 characters
+	Name string `json:"name,omitempty"`
+
+	// Optional. Point in time when the link will expire
+	ExpireDate time.Time `json:"-"`
+
+	// Optional. How long the link is valid for, used when ExpireDate is not set
+	Duration time.Duration `json:"-"`
+
+	// Optional. The maximum number of users that can be members of the chat simultaneously
+	// after joining the chat via this invite link; 1-99999
+	MemberLimit int `json:"member_limit,omitempty"`
+
+	// Optional. True, if users joining the chat via the link need to be approved by chat administrators.
+	// If True, member_limit can't be specified
+	CreatesJoinRequest bool `json:"creates_join_request,omitempty"`
+}
+
+func (params CreateChatInviteLinkParams) MarshalJSON() ([]byte, error) {
+	type createChatInviteLinkParams CreateChatInviteLinkParams
+	return json.Marshal(struct {
+		createChatInviteLinkParams
+		ExpireDate int64 `json:"expire_date,omitempty"`
+	}{createChatInviteLinkParams(params), untilDate(params.ExpireDate, params.Duration)})
+}
+
+type EditChatInviteLinkParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// The invite link to edit
+	InviteLink string `json:"invite_link"`
+
+	// Optional. Invite link name; 0-32 characters
+	Name string `json:"name,omitempty"`
+
+	// Optional. Point in time when the link will expire
+	ExpireDate time.Time `json:"-"`
+
+	// Optional. How long the link is valid for, used when ExpireDate is not set
+	Duration time.Duration `json:"-"`
+
+	// Optional. The maximum number of users that can be members of the chat simultaneously
+	// after joining the chat via this invite link; 1-99999
+	MemberLimit int `json:"member_limit,omitempty"`
+
+	// Optional. True, if users joining the chat via the link need to be approved by chat administrators.
+	// If True, member_limit can't be specified
+	CreatesJoinRequest bool `json:"creates_join_request,omitempty"`
+}
+
+func (params EditChatInviteLinkParams) MarshalJSON() ([]byte, error) {
+	type editChatInviteLinkParams EditChatInviteLinkParams
+	return json.Marshal(struct {
+		editChatInviteLinkParams
+		ExpireDate int64 `json:"expire_date,omitempty"`
+	}{editChatInviteLinkParams(params), untilDate(params.ExpireDate, params.Duration)})
+}
+
+type RevokeChatInviteLinkParams struct {
+	// Unique identifier of the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// The invite link to revoke
+	InviteLink string `json:"invite_link"`
+}
+
+type ChatJoinRequestParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Unique identifier of the target user
+	UserId int64 `json:"user_id"`
+}
+
+type CreateForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Topic name, 1-128 characters
+	Name string `json:"name"`
+
+	// Optional. Color of the topic icon in RGB format. Currently, must be one of the ForumTopicIconColor constants
+	IconColor int `json:"icon_color,omitempty"`
+
+	// Optional. Unique identifier of the custom emoji shown as the topic icon.
+	// Use GetForumTopicIconStickers to get all allowed custom emoji identifiers.
+	IconCustomEmojiId string `json:"icon_custom_emoji_id,omitempty"`
+}
+
+type EditForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Unique identifier for the target message thread of the forum topic
+	MessageThreadId int `json:"message_thread_id"`
+
+	// Optional. New topic name, 0-128 characters. If not specified or empty,
+	// the current name of the topic will be kept
+	Name string `json:"name,omitempty"`
+
+	// Optional. New unique identifier of the custom emoji shown as the topic icon.
+	// Use GetForumTopicIconStickers to get all allowed custom emoji identifiers.
+	// Pass an empty string to remove the icon. If not specified, the current icon will be kept
+	IconCustomEmojiId *string `json:"icon_custom_emoji_id,omitempty"`
+}
+
+type ForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Unique identifier for the target message thread of the forum topic
+	MessageThreadId int `json:"message_thread_id"`
+}
+
+type EditGeneralForumTopicParams struct {
+	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// New topic name, 1-128 characters
+	Name string `json:"name"`
+}
+
+type SetMyCommandsParams struct {
+	// A JSON-serialized list of bot commands to be set as the list of the bot's commands.
+	// At most 100 commands can be specified.
+	Commands []BotCommand `json:"commands"`
+
+	// Optional. A JSON-serialized object, describing scope of users
+	// for which the commands are relevant.
+	// Defaults to BotCommandScopeDefault.
+	Scope BotCommandScope `json:"scope,omitempty"`
+
+	// Optional. A two-letter ISO 639-1 language code. If empty,
+	// commands will be applied to all users from the given scope,
+	// for whose language there are no dedicated commands
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+type DeleteMyCommandsParams struct {
+	// Optional. A JSON-serialized object, describing
+	// scope of users for which the commands are relevant.
+	// Defaults to BotCommandScopeDefault.
+	Scope BotCommandScope `json:"scope,omitempty"`
+
+	// Optional. A two-letter ISO 639-1 language code.
+	// If empty, commands will be applied to all users from the given scope,
+	// for whose language there are no dedicated commands
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+type GetMyCommandsParams struct {
+	// Optional. A JSON-serialized object, describing scope of users.
+	// Defaults to BotCommandScopeDefault.
+	Scope BotCommandScope `json:"scope,omitempty"`
+
+	// Optional. A two-letter ISO 639-1 language code or an empty string
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+type SetMyNameParams struct {
+	// Optional. New bot name; 0-64 characters. Pass an empty string to remove
+	// the dedicated name for the given language.
+	Name string `json:"name"`
+
+	// Optional. A two-letter ISO 639-1 language code.
+	// If empty, the name will be shown to all users for whose language there is no dedicated name.
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+type SetMyDescriptionParams struct {
+	// Optional. New bot description; 0-512 characters.
+	// Pass an empty string to remove the dedicated description for the given language.
+	Description string `json:"description"`
+
+	// Optional. A two-letter ISO 639-1 language code. If empty, the description
+	// will be applied to all users for whose language there is no dedicated description.
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+type SetMyShortDescriptionParams struct {
+	// Optional. New short description for the bot; 0-120 characters.
+	// Pass an empty string to remove the dedicated short description for the given language.
+	ShortDescription string `json:"short_description"`
+
+	// Optional. A two-letter ISO 639-1 language code. If empty, the short description
+	// will be applied to all users for whose language there is no dedicated short description.
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+type LanguageCodeParams struct {
+	// Optional. A two-letter ISO 639-1 language code or an empty string
+	LanguageCode string `json:"language_code,omitempty"`
+}
+
+type SetChatMenuButtonParams struct {
+	// Optional. Unique identifier for the target private chat.
+	// If not specified, default bot's menu button will be changed
+	ChatId int64 `json:"chat_id,omitempty"`
+
+	// Optional. A JSON-serialized object for the bot's new menu button.
+	// Defaults to MenuButtonDefault
+	MenuButton MenuButton `json:"menu_button,omitempty"`
+}
+
+type GetChatMenuButtonParams struct {
+	// Optional. Unique identifier for the target private chat.
+	// If not specified, default bot's menu button will be returned
+	ChatId int64 `json:"chat_id,omitempty"`
+}
+
+type SetMyDefaultAdministratorRightsParams struct {
+	// Optional. A JSON-serialized object describing new default administrator rights.
+	// If not specified, the default administrator rights will be cleared.
+	Rights *ChatAdministratorRights `json:"rights,omitempty"`
+
+	// Optional. Pass True to change the default administrator rights of the bot in channels. Otherwise,
+	// the default administrator rights of the bot for groups and supergroups will be changed.
+	ForChannels bool `json:"for_channels,omitempty"`
+}
+
+type GetMyDefaultAdministratorRightsParams struct {
+	// Optional. Pass True to get default administrator rights of the bot in channels.
+	// Otherwise, default administrator rights of the bot for groups and supergroups will be returned.
+	ForChannels bool `json:"for_channels,omitempty"`
+}
@@ -0,0 +1,159 @@
+package gogram
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+// Middleware wraps the handling of an update. It can act before and after calling next,
+// change the Context, or not call next at all to drop the update.
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// Chain returns a middleware which applies middlewares in order, the first one being the outermost.
+// It can also be used to wrap a single handler: Chain(Recover())(handler).
+func Chain(middlewares ...Middleware) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		for i := len(middlewares) - 1; i >= 0; i-- {
+			next = middlewares[i](next)
+		}
+		return next
+	}
+}
+
+// Use adds middlewares that wrap the handling of every update by the router, before the update
+// reaches the handler groups. They run in the order they were added. A middleware which
+// drops an update can return ErrStop, which is not reported as an error.
+// Middlewares must be added before Run is called.
+func (router *Router) Use(middlewares ...Middleware) {
+	router.middlewares = append(router.middlewares, middlewares...)
+}
+
+// PanicError is returned by the handler wrapped by Recover when it panics
+type PanicError struct {
+	// The value passed to panic
+	Value any
+
+	// Stack trace of the goroutine at the moment of the panic
+	Stack []byte
+}
+
+func (err *PanicError) Error() string {
+	return fmt.Sprintf("gogram: handler panicked: %v", err.Value)
+}
+
+// Recover returns a middleware which turns a panic in the handler into a *PanicError
+func Recover() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx *Context) (err error) {
+			defer func() {
+				if value := recover(); value != nil {
+					err = &PanicError{Value: value, Stack: debug.Stack()}
+				}
+			}()
+			return next(ctx)
+		}
+	}
+}
+
+// Timing returns a middleware which logs how long the handling of every update took,
+// using the bot's logger at debug level
+func Timing() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx *Context) error {
+			start := time.Now()
+			err := next(ctx)
+			logger := slog.Default()
+			if ctx.Bot != nil {
+				logger = ctx.Bot.logger()
+			}
+			attrs := []slog.Attr{
+				slog.Int("update_id", ctx.Update.UpdateId),
+				slog.Duration("duration", time.Since(start)),
+			}
+			if err != nil && !errors.Is(err, ErrStop) {
+				attrs = append(attrs, slog.String("error", err.Error()))
+			}
+			logger.LogAttrs(ctx, slog.LevelDebug, "Update handled", attrs...)
+			return err
+		}
+	}
+}
+
+// Allow returns a middleware which drops the updates not passing filter, for example
+// to let only administrators use the bot: Allow(FromUser(adminIds...))
+func Allow(filter Filter) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx *Context) error {
+			if !filter(ctx) {
+				return ErrStop
+			}
+			return next(ctx)
+		}
+	}
+}
+
+// Throttle returns a middleware which lets at most limit updates from every user through
+// in each interval and drops the rest. Updates which weren't caused by a user are not limited.
+// Throttle panics if limit or interval is not positive.
+func Throttle(limit int, interval time.Duration) Middleware {
+	if limit <= 0 || interval <= 0 {
+		panic(fmt.Sprintf("gogram: invalid throttle limit %d per %s", limit, interval))
+	}
+	throttler := &throttler{
+		limit:    limit,
+		interval: interval,
+		windows:  make(map[int64]*throttleWindow),
+	}
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx *Context) error {
+			sender := ctx.Sender()
+			if sender != nil && !throttler.allow(sender.Id, time.Now()) {
+				return ErrStop
+			}
+			return next(ctx)
+		}
+	}
+}
+
+// throttler counts the updates from every user in fixed time windows
+type throttler struct {
+	limit    int
+	interval time.Duration
+
+	mu          sync.Mutex
+	windows     map[int64]*throttleWindow
+	lastCleanup time.Time
+}
+
+type throttleWindow struct {
+	start time.Time
+	count int
+}
+
+// Reports whether another update from the user fits into the limit
+func (throttler *throttler) allow(userId int64, now time.Time) bool {
+	throttler.mu.Lock()
+	defer throttler.mu.Unlock()
+
+	// Forget the users whose windows have ended, so the map doesn't grow forever
+	if now.Sub(throttler.lastCleanup) >= throttler.interval {
+		for id, window := range throttler.windows {
+			if now.Sub(window.start) >= throttler.interval {
+				delete(throttler.windows, id)
+			}
+		}
+		throttler.lastCleanup = now
+	}
+
+	window := throttler.windows[userId]
+	if window == nil || now.Sub(window.start) >= throttler.interval {
+		window = &throttleWindow{start: now}
+		throttler.windows[userId] = window
+	}
+	window.count++
+	return window.count <= throttler.limit
+}
@@ -0,0 +1,89 @@
+package gogram
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestChainOrder(t *testing.T) {
+	var calls []string
+	middleware := func(name string) Middleware {
+		return func(next HandlerFunc) HandlerFunc {
+			return func(ctx *Context) error {
+				calls = append(calls, name)
+				return next(ctx)
+			}
+		}
+	}
+	handler := Chain(middleware("first"), middleware("second"))(func(ctx *Context) error {
+		calls = append(calls, "handler")
+		return nil
+	})
+	if err := handler(NewContext(context.Background(), nil, textUpdate("hi"))); err != nil {
+		t.Fatal(err)
+	}
+	if len(calls) != 3 || calls[0] != "first" || calls[1] != "second" || calls[2] != "handler" {
+		t.Errorf("called %v, want [first second handler]", calls)
+	}
+}
+
+func TestRecover(t *testing.T) {
+	handler := Recover()(func(ctx *Context) error { panic("boom") })
+	err := handler(NewContext(context.Background(), nil, textUpdate("hi")))
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) || panicErr.Value != "boom" {
+		t.Errorf("error = %v, want *PanicError with boom", err)
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	throttler := &throttler{limit: 2, interval: time.Second, windows: make(map[int64]*throttleWindow)}
+	start := time.Now()
+	for i, want := range []bool{true, true, false} {
+		if got := throttler.allow(1, start); got != want {
+			t.Errorf("update %d allowed = %v, want %v", i+1, got, want)
+		}
+	}
+	if !throttler.allow(2, start) {
+		t.Error("update from another user not allowed")
+	}
+	if !throttler.allow(1, start.Add(time.Second)) {
+		t.Error("update in the next window not allowed")
+	}
+
+	handled := 0
+	handler := Throttle(1, time.Minute)(func(ctx *Context) error {
+		handled++
+		return nil
+	})
+	for i := 0; i < 2; i++ {
+		err := handler(NewContext(context.Background(), nil, textUpdate("hi")))
+		if i == 1 && !errors.Is(err, ErrStop) {
+			t.Errorf("throttled update error = %v, want ErrStop", err)
+		}
+	}
+	if handled != 1 {
+		t.Errorf("handled %d updates, want 1", handled)
+	}
+}
+
+func TestThrottleInvalid(t *testing.T) {
+	for _, limit := range []int{0, -1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Throttle(%d, time.Second) didn't panic", limit)
+				}
+			}()
+			Throttle(limit, time.Second)
+		}()
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("Throttle(1, 0) didn't panic")
+		}
+	}()
+	Throttle(1, 0)
+}
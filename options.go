@@ -0,0 +1,59 @@
+package gogram
+
+import (
+	"log/slog"
+	"net/http"
+)
+
+// Option configures a Bot created by NewBot
+type Option func(bot *Bot)
+
+// WithDebug makes the bot log every request at info level instead of debug level
+func WithDebug(debug bool) Option {
+	return func(bot *Bot) {
+		bot.Debug = debug
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for all requests
+func WithHTTPClient(client *http.Client) Option {
+	return func(bot *Bot) {
+		bot.Client = client
+	}
+}
+
+// WithServerURL sets the base URL of the Bot API server, e.g. the address of a local Bot API server
+func WithServerURL(url string) Option {
+	return func(bot *Bot) {
+		bot.ServerURL = url
+	}
+}
+
+// WithFileURL sets the base URL used to download files
+func WithFileURL(url string) Option {
+	return func(bot *Bot) {
+		bot.FileURL = url
+	}
+}
+
+// WithLogger sets the logger the bot writes to
+func WithLogger(logger *slog.Logger) Option {
+	return func(bot *Bot) {
+		bot.Logger = logger
+	}
+}
+
+// WithoutStartupCheck makes NewBot skip the getMe call, so no request is made
+// until the bot is used. Bot.Self stays nil in that case.
+func WithoutStartupCheck() Option {
+	return func(bot *Bot) {
+		bot.skipStartupCheck = true
+	}
+}
+
+// WithInterceptors adds interceptors wrapping every request the bot makes, see Bot.Interceptors
+func WithInterceptors(interceptors ...Interceptor) Option {
+	return func(bot *Bot) {
+		bot.Interceptors = append(bot.Interceptors, interceptors...)
+	}
+}
@@ -1,4 +1,4 @@
-package main
+package gogram
 
 type LabeledPrice struct {
 	// Portion label
@@ -0,0 +1,284 @@
+package gogram
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"maps"
+	"sort"
+	"sync"
+)
+
+// HandlerFunc handles an update. Besides nil and other errors, it can return ErrContinue
+// or ErrStop to control which handlers are called next.
+type HandlerFunc func(ctx *Context) error
+
+var (
+	// Returned by a handler to let the next matching handler of the same group handle the update
+	ErrContinue = errors.New("gogram: continue handling the update in the group")
+
+	// Returned by a handler to stop handling the update, so the handlers of the following groups are not called
+	ErrStop = errors.New("gogram: stop handling the update")
+
+	// Returned by Context.Send and Context.Reply for updates which don't come from a chat
+	ErrNoChat = errors.New("gogram: update doesn't come from a chat")
+)
+
+// Router dispatches updates to handlers. Handlers are arranged in groups, which are visited
+// in ascending order. In every group, the first handler whose filters pass the update handles it,
+// unless it returns ErrContinue, in which case the next matching handler of the group is tried.
+// After a group, the update goes on to the next one, unless the handler returned ErrStop or another error.
+//
+// Handlers registered on the Router itself belong to group 0. Handlers must be registered before Run is called.
+type Router struct {
+	*HandlerGroup
+
+	// Bot passed to the handlers in Context
+	Bot *Bot
+
+	// Number of updates handled at the same time. Updates from the same chat are always handled
+	// one after another, in the order they were received. Values below 1 mean 1
+	Workers int
+
+	// Called with errors returned by handlers other than ErrContinue and ErrStop.
+	// If nil, the errors are logged by the bot's logger
+	ErrorHandler func(ctx *Context, err error)
+
+	groups      []*HandlerGroup
+	middlewares []Middleware
+}
+
+// HandlerGroup is an ordered list of handlers, see Router
+type HandlerGroup struct {
+	order  int
+	routes []route
+}
+
+// A handler together with the filter for the updates it handles
+type route struct {
+	filter Filter
+	handle HandlerFunc
+}
+
+// Creates new router which passes bot to the handlers
+func NewRouter(bot *Bot) *Router {
+	router := &Router{Bot: bot}
+	router.HandlerGroup = router.Group(0)
+	return router
+}
+
+// Group returns the handler group with the given order, creating it if needed.
+// Groups with lower order handle updates first
+func (router *Router) Group(order int) *HandlerGroup {
+	i := sort.Search(len(router.groups), func(i int) bool {
+		return router.groups[i].order >= order
+	})
+	if i < len(router.groups) && router.groups[i].order == order {
+		return router.groups[i]
+	}
+	group := &HandlerGroup{order: order}
+	router.groups = append(router.groups, nil)
+	copy(router.groups[i+1:], router.groups[i:])
+	router.groups[i] = group
+	return group
+}
+
+// Run handles the updates received from updates until the channel is closed or ctx is cancelled,
+// and waits for the handlers to return. It returns ctx.Err() if ctx was cancelled.
+// Every update received from the channel is handled, even if ctx is cancelled before a worker
+// is free for it, as StartPolling and WebhookHandler count received updates as delivered.
+func (router *Router) Run(ctx context.Context, updates <-chan Update) error {
+	workers := router.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	queues := make([]chan Update, workers)
+	var wg sync.WaitGroup
+	for i := range queues {
+		queues[i] = make(chan Update)
+		wg.Add(1)
+		go func(queue <-chan Update) {
+			defer wg.Done()
+			for update := range queue {
+				router.handle(ctx, update)
+			}
+		}(queues[i])
+	}
+	defer func() {
+		for _, queue := range queues {
+			close(queue)
+		}
+		wg.Wait()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case update, ok := <-updates:
+			if !ok {
+				return nil
+			}
+			// The update can't be given back, so wait for its worker even if ctx is cancelled
+			queues[updateShard(&update)%uint64(workers)] <- update
+		}
+	}
+}
+
+// Returns a number which is the same for all updates from a chat, or from a user outside of chats
+func updateShard(update *Update) uint64 {
+	ctx := &Context{Update: update}
+	if chat := ctx.Chat(); chat != nil {
+		return uint64(chat.Id)
+	}
+	if sender := ctx.Sender(); sender != nil {
+		return uint64(sender.Id)
+	}
+	return 0
+}
+
+// Handles the update and reports the error returned by its handlers
+func (router *Router) handle(ctx context.Context, update Update) {
+	handlerCtx := NewContext(ctx, router.Bot, &update)
+	if err := router.dispatch(handlerCtx); err != nil {
+		router.handleError(handlerCtx, err)
+	}
+}
+
+// Dispatch passes the update through the middlewares to the handlers and returns the error
+// returned by a handler, if any. Unlike Run, it doesn't pass the error to ErrorHandler.
+func (router *Router) Dispatch(ctx context.Context, update *Update) error {
+	return router.dispatch(NewContext(ctx, router.Bot, update))
+}
+
+func (router *Router) dispatch(ctx *Context) error {
+	err := Chain(router.middlewares...)(router.dispatchGroups)(ctx)
+	if errors.Is(err, ErrStop) || errors.Is(err, ErrContinue) {
+		return nil
+	}
+	return err
+}
+
+// Passes the update to the handler groups in order
+func (router *Router) dispatchGroups(ctx *Context) error {
+	for _, group := range router.groups {
+		err := group.dispatch(ctx)
+		if errors.Is(err, ErrStop) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (router *Router) handleError(ctx *Context, err error) {
+	if router.ErrorHandler != nil {
+		router.ErrorHandler(ctx, err)
+		return
+	}
+	logger := slog.Default()
+	if router.Bot != nil {
+		logger = router.Bot.logger()
+	}
+	logger.ErrorContext(ctx, "Handling update failed", "update_id", ctx.Update.UpdateId, "error", err)
+}
+
+// Calls the handlers of the group until one handles the update
+func (group *HandlerGroup) dispatch(ctx *Context) error {
+	for _, route := range group.routes {
+		values := maps.Clone(ctx.values)
+		if !route.filter(ctx) {
+			// Drop the values stored by the filters which passed before another one failed,
+			// like the match of Text, so they don't reach the handlers of other routes
+			ctx.values = values
+			continue
+		}
+		if err := route.handle(ctx); !errors.Is(err, ErrContinue) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Handle registers handler for the updates passing all of filters
+func (group *HandlerGroup) Handle(handler HandlerFunc, filters ...Filter) {
+	group.routes = append(group.routes, route{filter: And(filters...), handle: handler})
+}
+
+// Registers handler for the updates which have the field selected by has and pass filters
+func (group *HandlerGroup) on(has func(update *Update) bool, handler HandlerFunc, filters []Filter) {
+	kind := func(ctx *Context) bool { return has(ctx.Update) }
+	group.Handle(handler, append([]Filter{kind}, filters...)...)
+}
+
+// OnMessage registers handler for new incoming messages passing all of filters
+func (group *HandlerGroup) OnMessage(handler HandlerFunc, filters ...Filter) {
+	group.on(func(update *Update) bool { return update.Message != nil }, handler, filters)
+}
+
+// OnEditedMessage registers handler for edited messages passing all of filters
+func (group *HandlerGroup) OnEditedMessage(handler HandlerFunc, filters ...Filter) {
+	group.on(func(update *Update) bool { return update.EditedMessage != nil }, handler, filters)
+}
+
+// OnChannelPost registers handler for new channel posts passing all of filters
+func (group *HandlerGroup) OnChannelPost(handler HandlerFunc, filters ...Filter) {
+	group.on(func(update *Update) bool { return update.ChannelPost != nil }, handler, filters)
+}
+
+// OnEditedChannelPost registers handler for edited channel posts passing all of filters
+func (group *HandlerGroup) OnEditedChannelPost(handler HandlerFunc, filters ...Filter) {
+	group.on(func(update *Update) bool { return update.EditedChannelPost != nil }, handler, filters)
+}
+
+// OnInlineQuery registers handler for inline queries passing all of filters
+func (group *HandlerGroup) OnInlineQuery(handler HandlerFunc, filters ...Filter) {
+	group.on(func(update *Update) bool { return update.InlineQuery != nil }, handler, filters)
+}
+
+// OnChosenInlineResult registers handler for chosen inline results passing all of filters
+func (group *HandlerGroup) OnChosenInlineResult(handler HandlerFunc, filters ...Filter) {
+	group.on(func(update *Update) bool { return update.ChosenInlineResult != nil }, handler, filters)
+}
+
+// OnCallbackQuery registers handler for callback queries passing all of filters
+func (group *HandlerGroup) OnCallbackQuery(handler HandlerFunc, filters ...Filter) {
+	group.on(func(update *Update) bool { return update.CallbackQuery != nil }, handler, filters)
+}
+
+// OnShippingQuery registers handler for shipping queries passing all of filters
+func (group *HandlerGroup) OnShippingQuery(handler HandlerFunc, filters ...Filter) {
+	group.on(func(update *Update) bool { return update.ShippingQuery != nil }, handler, filters)
+}
+
+// OnPreCheckoutQuery registers handler for pre-checkout queries passing all of filters
+func (group *HandlerGroup) OnPreCheckoutQuery(handler HandlerFunc, filters ...Filter) {
+	group.on(func(update *Update) bool { return update.PreCheckoutQuery != nil }, handler, filters)
+}
+
+// OnPoll registers handler for poll state updates passing all of filters
+func (group *HandlerGroup) OnPoll(handler HandlerFunc, filters ...Filter) {
+	group.on(func(update *Update) bool { return update.Poll != nil }, handler, filters)
+}
+
+// OnPollAnswer registers handler for poll answers passing all of filters
+func (group *HandlerGroup) OnPollAnswer(handler HandlerFunc, filters ...Filter) {
+	group.on(func(update *Update) bool { return update.PollAnswer != nil }, handler, filters)
+}
+
+// OnMyChatMember registers handler for changes of the bot's chat member status passing all of filters
+func (group *HandlerGroup) OnMyChatMember(handler HandlerFunc, filters ...Filter) {
+	group.on(func(update *Update) bool { return update.MyChatMember != nil }, handler, filters)
+}
+
+// OnChatMember registers handler for changes of chat member statuses passing all of filters
+func (group *HandlerGroup) OnChatMember(handler HandlerFunc, filters ...Filter) {
+	group.on(func(update *Update) bool { return update.ChatMember != nil }, handler, filters)
+}
+
+// OnChatJoinRequest registers handler for chat join requests passing all of filters
+func (group *HandlerGroup) OnChatJoinRequest(handler HandlerFunc, filters ...Filter) {
+	group.on(func(update *Update) bool { return update.ChatJoinRequest != nil }, handler, filters)
+}
@@ -0,0 +1,177 @@
+package gogram
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRouterGroups(t *testing.T) {
+	router := NewRouter(nil)
+	var calls []string
+	handler := func(name string, err error) HandlerFunc {
+		return func(ctx *Context) error {
+			calls = append(calls, name)
+			return err
+		}
+	}
+	router.Group(1).OnMessage(handler("group 1", ErrStop))
+	router.Group(2).OnMessage(handler("group 2", nil))
+	router.OnMessage(handler("continued", ErrContinue))
+	router.OnMessage(handler("skipped", nil), Text("^nothing$"))
+	router.OnMessage(handler("handled", nil))
+	router.OnMessage(handler("after handled", nil))
+
+	if err := router.Dispatch(context.Background(), textUpdate("hi")); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"continued", "handled", "group 1"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("called %v, want %v", calls, want)
+	}
+}
+
+func TestRouterHandlerError(t *testing.T) {
+	router := NewRouter(nil)
+	failure := errors.New("failure")
+	router.OnMessage(func(ctx *Context) error { return failure })
+	router.Group(1).OnMessage(func(ctx *Context) error {
+		t.Error("handler called after an error")
+		return nil
+	})
+	if err := router.Dispatch(context.Background(), textUpdate("hi")); !errors.Is(err, failure) {
+		t.Errorf("Dispatch() error = %v, want %v", err, failure)
+	}
+}
+
+func TestTextMatchOfFailedRoute(t *testing.T) {
+	router := NewRouter(nil)
+	router.OnMessage(func(ctx *Context) error {
+		t.Error("handler with a failing filter called")
+		return nil
+	}, Text(`^ban (\w+)$`), FromUser(42))
+	var match any
+	router.OnMessage(func(ctx *Context) error {
+		match, _ = ctx.Get(TextMatchKey)
+		return nil
+	})
+
+	if err := router.Dispatch(context.Background(), textUpdate("ban spammer")); err != nil {
+		t.Fatal(err)
+	}
+	if match != nil {
+		t.Errorf("match %v of a route whose filter failed reached another route", match)
+	}
+}
+
+func TestTextMatch(t *testing.T) {
+	router := NewRouter(nil)
+	var match []string
+	router.OnMessage(func(ctx *Context) error {
+		value, _ := ctx.Get(TextMatchKey)
+		match, _ = value.([]string)
+		return nil
+	}, Text(`^ban (\w+)$`), FromUser(2))
+
+	if err := router.Dispatch(context.Background(), textUpdate("ban spammer")); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"ban spammer", "spammer"}; !reflect.DeepEqual(match, want) {
+		t.Errorf("match = %q, want %q", match, want)
+	}
+}
+
+func TestContextReply(t *testing.T) {
+	var params map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&params)
+		w.Write([]byte(`{"ok":true,"result":{"message_id":2}}`))
+	}))
+	defer server.Close()
+
+	update := textUpdate("hi")
+	update.Message.MessageId = 10
+	update.Message.IsTopicMessage = true
+	update.Message.MessageThreadId = 5
+	ctx := NewContext(context.Background(), newTestBot(t, server.URL), update)
+	if _, err := ctx.Reply("hello"); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"chat_id":                     float64(1),
+		"text":                        "hello",
+		"reply_to_message_id":         float64(10),
+		"allow_sending_without_reply": true,
+		"message_thread_id":           float64(5),
+	}
+	if !reflect.DeepEqual(params, want) {
+		t.Errorf("sent %v, want %v", params, want)
+	}
+}
+
+func TestContextReplyWithoutChat(t *testing.T) {
+	ctx := NewContext(context.Background(), nil, &Update{InlineQuery: &InlineQuery{}})
+	if _, err := ctx.Reply("hello"); !errors.Is(err, ErrNoChat) {
+		t.Errorf("Reply() error = %v, want ErrNoChat", err)
+	}
+}
+
+func TestRouterRunHandlesReceivedUpdates(t *testing.T) {
+	server := newFakeUpdatesServer(t, 3)
+	bot := newTestBot(t, server.URL)
+	router := NewRouter(bot)
+
+	var mu sync.Mutex
+	handled := make(map[int]bool)
+	started := make(chan struct{})
+	router.Handle(func(ctx *Context) error {
+		if ctx.Update.UpdateId == 1 {
+			// Keep the only worker busy until polling and the router are shut down
+			close(started)
+			<-ctx.Done()
+			time.Sleep(20 * time.Millisecond)
+		}
+		mu.Lock()
+		handled[ctx.Update.UpdateId] = true
+		mu.Unlock()
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	updates := bot.StartPolling(ctx)
+	done := make(chan error)
+	go func() { done <- router.Run(ctx, updates) }()
+	<-started
+	// Let Run receive the next update while the worker is busy
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) && err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+
+	// Wait for polling to confirm the received updates on the server
+	confirmed := 0
+	for deadline := time.Now().Add(5 * time.Second); confirmed == 0 && time.Now().Before(deadline); {
+		for _, call := range server.Calls() {
+			if call.params.Limit == 1 {
+				confirmed = call.params.Offset
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if confirmed == 0 {
+		t.Fatal("received updates not confirmed")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	for id := 1; id < confirmed; id++ {
+		if !handled[id] {
+			t.Errorf("update %d confirmed but not handled", id)
+		}
+	}
+}
@@ -1,4 +1,4 @@
-package main
+package gogram
 
 type Sticker struct {
 	// Identifier for this file, which can be used to download or reuse the file
@@ -99,7 +99,7 @@ type InputSticker struct {
 	// from the Internet, upload a new one using multipart/form-data,
 	// or pass “attach://<file_attach_name>” to upload a new one using multipart/form-data
 	// under <file_attach_name> name. Animated and video stickers can't be uploaded via HTTP URL
-	Sicker interface{} `json:"sticker"`
+	Sticker *InputFile `json:"sticker"`
 
 	// List of 1-20 emoji associated with the sticker
 	EmojiList []string `json:"emoji_list"`
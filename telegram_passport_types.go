@@ -1,4 +1,4 @@
-package main
+package gogram
 
 type PassportData struct {
 	// Array with information about documents and other
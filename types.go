@@ -1,4 +1,4 @@
-package main
+package gogram
 
 type User struct {
 	// Unique identifier for this user or bot.
@@ -158,7 +158,7 @@ type Message struct {
 	// Optional. Sender of the message; empty for messages sent to channels.
 	// For backward compatibility, the field contains a fake sender user in non-channel chats,
 	// if the message was sent on behalf of a chat.
-	From User `json:"From"`
+	From *User `json:"from"`
 
 	// Optional. Sender of the message, sent on behalf of a chat.
 	// For example, the channel itself for channel posts,
@@ -239,12 +239,15 @@ type Message struct {
 	Document *Document `json:"document"`
 
 	// Optional. Message is a photo, available sizes of the photo
-	photo []PhotoSize `json:"photo"`
+	Photo []PhotoSize `json:"photo"`
 
 	// Optional. Message is a sticker, information about the sticker
 	Sticker *Sticker `json:"sticker"`
 
 	// Optional. Message is a video, information about the video
+	Video *Video `json:"video"`
+
+	// Optional. Message is a video note, information about the video message
 	VideoNote *VideoNote `json:"video_note"`
 
 	// Optional. Message is a voice message, information about the file
@@ -412,17 +415,17 @@ type MessageEntity struct {
 	Length int `json:"length"`
 
 	// Optional. For “text_link” only, URL that will be opened after user taps on the text
-	Url string `json:"url"`
+	Url string `json:"url,omitempty"`
 
 	// Optional. For “text_mention” only, the mentioned user
-	User *User `json:"user"`
+	User *User `json:"user,omitempty"`
 
 	// Optional. For “pre” only, the programming language of the entity text
-	Language string `json:"language"`
+	Language string `json:"language,omitempty"`
 
 	// Optional. For “custom_emoji” only, unique identifier of the custom emoji.
 	// Use getCustomEmojiStickers to get full information about the sticker
-	CustomEmojiId string `json:"custom_emoji_id"`
+	CustomEmojiId string `json:"custom_emoji_id,omitempty"`
 }
 
 type PhotoSize struct {
@@ -791,7 +794,7 @@ type ForumTopicCreated struct {
 type ForumTopicClosed struct {
 	// This object represents a service message about a forum topic closed in the chat.
 	// Currently holds no information.
-	_ interface{} `json:""`
+	_ interface{}
 }
 
 type ForumTopicEdited struct {
@@ -806,19 +809,19 @@ type ForumTopicEdited struct {
 type ForumTopicReopened struct {
 	// This object represents a service message about a forum topic reopened in the chat.
 	// Currently holds no information.
-	_ interface{} `json:""`
+	_ interface{}
 }
 
 type GeneralForumTopicHidden struct {
 	// This object represents a service message about General forum topic hidden in the chat.
 	// Currently holds no information.
-	_ interface{} `json:""`
+	_ interface{}
 }
 
 type GeneralForumTopicUnhidden struct {
 	// This object represents a service message about General forum topic unhidden in the chat.
 	// Currently holds no information.
-	_ interface{} `json:""`
+	_ interface{}
 }
 
 type UserShared struct {
@@ -861,7 +864,7 @@ type VideoChatScheduled struct {
 type VideoChatStarted struct {
 	// This object represents a service message about a video chat started in the chat.
 	// Currently holds no information.
-	_ interface{} `json:""`
+	_ interface{}
 }
 
 type VideoChatEnded struct {
@@ -898,7 +901,8 @@ type File struct {
 	// are safe for storing this value.
 	FileSize int `json:"file_size"`
 
-	// Optional. File path. Use https://api.telegram.org/file/bot<token>/<file_path> to get the file.
+	// Optional. File path. Use https://api.telegram.org/file/bot<token>/<file_path> to get the file,
+	// or Bot.DownloadFile which does that. With a local Bot API server, it is an absolute local path.
 	FilePath string `json:"file_path"`
 }
 
@@ -909,28 +913,28 @@ type WebAppInfo struct {
 
 type ReplyKeyboardMarkup struct {
 	// Array of button rows, each represented by an Array of KeyboardButton objects
-	Keyboard [][]KeyboardButton `json:"KeyboardButton"`
+	Keyboard [][]KeyboardButton `json:"keyboard"`
 
 	// Optional. Requests clients to always show the keyboard
 	// when the regular keyboard is hidden.
 	// Defaults to false, in which case the custom keyboard
 	// can be hidden and opened with a keyboard icon.
-	IsPersistent bool `json:"is_persistent"`
+	IsPersistent bool `json:"is_persistent,omitempty"`
 
 	// Optional. Requests clients to resize the keyboard vertically for optimal
 	// fit (e.g., make the keyboard smaller if there are just two rows of buttons). Defaults to false,
 	// in which case the custom keyboard is always of the same height as the app's standard keyboard.
-	ResizeKeyboard bool `json:"resize_keyboard"`
+	ResizeKeyboard bool `json:"resize_keyboard,omitempty"`
 
 	// Optional. Requests clients to hide the keyboard as soon as it's been used.
 	// The keyboard will still be available, but clients will automatically
 	// display the usual letter-keyboard in the chat - the user can press
 	// a special button in the input field to see the custom keyboard again. Defaults to false.
-	OneTimeKeyboard bool `json:"one_time_keyboard"`
+	OneTimeKeyboard bool `json:"one_time_keyboard,omitempty"`
 
 	// Optional. The placeholder to be shown in the input
 	// field when the keyboard is active; 1-64 characters
-	InputFieldPlaceholder string `json:"input_field_placeholder"`
+	InputFieldPlaceholder string `json:"input_field_placeholder,omitempty"`
 
 	// Optional. Use this parameter if you want to show the keyboard to specific users only.
 	// Targets: 1) users that are @mentioned in the text of the Message object;
@@ -938,7 +942,7 @@ type ReplyKeyboardMarkup struct {
 
 	// Example: A user requests to change the bot's language, bot replies to the request
 	// with a keyboard to select the new language. Other users in the group don't see the keyboard.
-	Selective bool `json:"selective"`
+	Selective bool `json:"selective,omitempty"`
 }
 
 type KeyboardButton struct {
@@ -950,29 +954,29 @@ type KeyboardButton struct {
 	// Optional. If specified, pressing the button will open a list of suitable users.
 	// Tapping on any user will send their
 	// identifier to the bot in a “user_shared” service message. Available in private chats only.
-	RequestUser *KeyboardButtonRequestUser `json:"request_user"`
+	RequestUser *KeyboardButtonRequestUser `json:"request_user,omitempty"`
 
 	// Optional. If specified, pressing the button will open a list of suitable chats.
 	// Tapping on a chat will send its
 	// identifier to the bot in a “chat_shared” service message. Available in private chats only.
-	RequestChat *KeyboardButtonRequestChat `json:"request_chat"`
+	RequestChat *KeyboardButtonRequestChat `json:"request_chat,omitempty"`
 
 	// Optional. If True, the user's phone number
 	// will be sent as a contact when the button is pressed. Available in private chats only.
-	RequestContact bool `json:"request_contact"`
+	RequestContact bool `json:"request_contact,omitempty"`
 
 	// Optional. If True, the user's current
 	// location will be sent when the button is pressed. Available in private chats only.
-	RequestLocation bool `json:"request_location"`
+	RequestLocation bool `json:"request_location,omitempty"`
 
 	// Optional. If specified, the user will be asked to create
 	// a poll and send it to the bot when the button is pressed. Available in private chats only.
-	RequestPoll *KeyboardButtonPollType `json:"request_poll"`
+	RequestPoll *KeyboardButtonPollType `json:"request_poll,omitempty"`
 
 	// Optional. If specified, the described Web App
 	// will be launched when the button is pressed. The Web App
 	// will be able to send a “web_app_data” service message. Available in private chats only.
-	WebApp *WebAppInfo `json:"web_app"`
+	WebApp *WebAppInfo `json:"web_app,omitempty"`
 }
 
 type KeyboardButtonRequestUser struct {
@@ -983,12 +987,12 @@ type KeyboardButtonRequestUser struct {
 	// Optional. Pass True to request a bot,
 	// pass False to request a regular user.
 	// If not specified, no additional restrictions are applied.
-	UserIsBot bool `json:"user_is_bot"`
+	UserIsBot *bool `json:"user_is_bot,omitempty"`
 
 	// Optional. Pass True to request a premium user,
 	// pass False to request a non-premium user.
 	// If not specified, no additional restrictions are applied.
-	UserIsPremium bool `json:"user_is_premium"`
+	UserIsPremium *bool `json:"user_is_premium,omitempty"`
 }
 
 type KeyboardButtonRequestChat struct {
@@ -1002,32 +1006,32 @@ type KeyboardButtonRequestChat struct {
 	// Optional. Pass True to request a forum supergroup,
 	// pass False to request a non-forum chat.
 	// If not specified, no additional restrictions are applied.
-	ChatIsForum bool `json:"chat_is_forum"`
+	ChatIsForum *bool `json:"chat_is_forum,omitempty"`
 
 	// Optional. Pass True to request a supergroup or a channel with a username,
 	// pass False to request a chat without a username.
 	// If not specified, no additional restrictions are applied.
-	ChatHasUsername bool `json:"chat_has_username"`
+	ChatHasUsername *bool `json:"chat_has_username,omitempty"`
 
 	// Optional. Pass True to request a chat owned by the user.
 	// Otherwise, no additional restrictions are applied.
-	ChatIsCreated bool `json:"chat_is_created"`
+	ChatIsCreated bool `json:"chat_is_created,omitempty"`
 
 	// Optional. A JSON-serialized object listing the required
 	// administrator rights of the user in the chat.
 	// The rights must be a superset of bot_administrator_rights.
 	// If not specified, no additional restrictions are applied.
-	UserAdministratorRights *ChatAdministratorRights `json:"user_administrator_rights"`
+	UserAdministratorRights *ChatAdministratorRights `json:"user_administrator_rights,omitempty"`
 
 	// Optional. A JSON-serialized object listing the required
 	// administrator rights of the bot in the chat.
 	// The rights must be a subset of user_administrator_rights.
 	// If not specified, no additional restrictions are applied.
-	BotAdministratorRights *ChatAdministratorRights `json:"bot_administrator_rights"`
+	BotAdministratorRights *ChatAdministratorRights `json:"bot_administrator_rights,omitempty"`
 
 	// Optional. Pass True to request a chat with the bot as a member.
 	// Otherwise, no additional restrictions are applied.
-	BotIsMember bool `json:"bot_is_member"`
+	BotIsMember bool `json:"bot_is_member,omitempty"`
 }
 
 type KeyboardButtonPollType struct {
@@ -1035,7 +1039,7 @@ type KeyboardButtonPollType struct {
 	// the user will be allowed to create only polls in the quiz mode.
 	// If regular is passed, only regular polls will be allowed.
 	// Otherwise, the user will be allowed to create a poll of any type.
-	Type string `json:"type"`
+	Type string `json:"type,omitempty"`
 }
 
 type ReplyKeyboardRemove struct {
@@ -1052,7 +1056,7 @@ type ReplyKeyboardRemove struct {
 	// Example: A user votes in a poll, bot returns confirmation message in reply to the vote
 	// and removes the keyboard for that user, while still showing the keyboard
 	// with poll options to users who haven't voted yet.
-	Selective bool `json:"selective"`
+	Selective bool `json:"selective,omitempty"`
 }
 
 type InlineKeyboardMarkup struct {
@@ -1067,20 +1071,20 @@ type InlineKeyboardButton struct {
 	// Links tg://user?id=<user_id> can be used to mention
 	// a user by their ID without using a username,
 	// if this is allowed by their privacy settings.
-	Url string `json:"url"`
+	Url string `json:"url,omitempty"`
 
 	// Optional. Data to be sent in a callback query to the bot when button is pressed, 1-64 bytes
-	CallbackData string `json:"callback_data"`
+	CallbackData string `json:"callback_data,omitempty"`
 
 	// 	Optional. Description of the Web App that will be launched when the user presses the button.
 	// The Web App will be able to send an arbitrary message on behalf of the user
 	// using the method answerWebAppQuery.
 	// Available only in private chats between a user and the bot.
-	WebApp *WebAppInfo `json:"web_app"`
+	WebApp *WebAppInfo `json:"web_app,omitempty"`
 
 	// Optional. An HTTPS URL used to automatically authorize the user.
 	// Can be used as a replacement for the Telegram Login Widget.
-	LoginUrl *LoginUrl `json:"login_url"`
+	LoginUrl *LoginUrl `json:"login_url,omitempty"`
 
 	// Optional. If set, pressing the button will prompt the user to select one of their chats,
 	// open that chat and insert the bot's username and the specified inline
@@ -1092,7 +1096,7 @@ type InlineKeyboardButton struct {
 	// Especially useful when combined with switch_pm… actions - in this case the user
 	// will be automatically returned to the chat they switched from,
 	// skipping the chat selection screen.
-	SwitchInlineQuery string `json:"switch_inline_query"`
+	SwitchInlineQuery *string `json:"switch_inline_query,omitempty"`
 
 	// Optional. If set, pressing the button will insert the bot's username
 	// and the specified inline query in the current chat's input field.
@@ -1100,24 +1104,24 @@ type InlineKeyboardButton struct {
 
 	// This offers a quick way for the user to open your bot in inline mode
 	// in the same chat - good for selecting something from multiple options.
-	SwitchInlineQueryCurrentChat string `json:"switch_inline_query_current_chat"`
+	SwitchInlineQueryCurrentChat *string `json:"switch_inline_query_current_chat,omitempty"`
 
 	// Optional. If set, pressing the button will prompt the user to select one
 	// of their chats of the specified type,
 	// open that chat and insert the bot's username
 	// and the specified inline query in the input field
-	SwitchInlineQueryChosenChat *SwitchInlineQueryChosenChat `json:"switch_inline_query_chosen_chat"`
+	SwitchInlineQueryChosenChat *SwitchInlineQueryChosenChat `json:"switch_inline_query_chosen_chat,omitempty"`
 
 	// Optional. Description of the game that will be launched when the user presses the button.
 
 	// NOTE: This type of button must always be the first button in the first row.
-	CallbackGame *CallbackGame `json:"callback_game"`
+	CallbackGame *CallbackGame `json:"callback_game,omitempty"`
 
 	// Optional. Specify True, to send a Pay button.
 
 	// NOTE: This type of button must always be the first button
 	// in the first row and can only be used in invoice messages.
-	Pay bool `json:"pay"`
+	Pay bool `json:"pay,omitempty"`
 }
 
 type LoginUrl struct {
@@ -1131,35 +1135,35 @@ type LoginUrl struct {
 	Url string `json:"url"`
 
 	// Optional. New text of the button in forwarded messages.
-	ForwardText string `json:"forward_text"`
+	ForwardText string `json:"forward_text,omitempty"`
 
 	// Optional. Username of a bot, which will be used for user authorization.
 	// See Setting up a bot for more details. If not specified,
 	// the current bot's username will be assumed.
 	// The url's domain must be the same as the domain linked with the bot.
 	// See Linking your domain to the bot for more details.
-	BotUsername string `json:"bot_username"`
+	BotUsername string `json:"bot_username,omitempty"`
 
 	// Optional. Pass True to request the permission for your bot to send messages to the user.
-	RequestWriteAccess bool `json:"request_write_access"`
+	RequestWriteAccess bool `json:"request_write_access,omitempty"`
 }
 
 type SwitchInlineQueryChosenChat struct {
 	// Optional. The default inline query to be inserted in the input field.
 	// If left empty, only the bot's username will be inserted
-	Query string `json:"query"`
+	Query string `json:"query,omitempty"`
 
 	// Optional. True, if private chats with users can be chosen
-	AllowUserChats bool `json:"allow_user_chats"`
+	AllowUserChats bool `json:"allow_user_chats,omitempty"`
 
 	// Optional. True, if private chats with bots can be chosen
-	AllowBotChats bool `json:"allow_bot_chats"`
+	AllowBotChats bool `json:"allow_bot_chats,omitempty"`
 
 	// Optional. True, if group and supergroup chats can be chosen
-	AllowGroupChats bool `json:"allow_group_chats"`
+	AllowGroupChats bool `json:"allow_group_chats,omitempty"`
 
 	// Optional. True, if channel chats can be chosen
-	AllowChannelChats bool `json:"allow_channel_chats"`
+	AllowChannelChats bool `json:"allow_channel_chats,omitempty"`
 }
 
 type CallbackQuery struct {
@@ -1203,13 +1207,13 @@ type ForceReply struct {
 
 	// Optional. The placeholder to be shown in the input field when the reply is active;
 	// 1-64 characters
-	InputFieldPlaceholder string `json:"input_field_placeholder"`
+	InputFieldPlaceholder string `json:"input_field_placeholder,omitempty"`
 
 	// Optional. Use this parameter if you want to force reply from specific users only.
 	// Targets: 1) users that are @mentioned in the text of the Message object;
 	// 2) if the bot's message is a reply (has reply_to_message_id), sender of the original message.
 
-	Selective bool `json:"selective"`
+	Selective bool `json:"selective,omitempty"`
 
 	// Example: A poll bot for groups runs in privacy mode
 	// (only receives commands, replies to its messages and mentions).
@@ -1417,8 +1421,9 @@ type ChatMemberRestricted struct {
 	// Information about the user
 	User *User `json:"user"`
 
-	// True, if the user is a member of the chat at the moment of the request
-	IsMember bool `json:"is_member"`
+	// True, if the user is a member of the chat at the moment of the request.
+	// Formerly named IsMember, which is now the method of the ChatMember interface
+	Member bool `json:"is_member"`
 
 	// True, if the user is allowed to send text messages, contacts, invoices, locations and venues
 	CanSendMessages bool `json:"can_send_messages"`
@@ -1498,10 +1503,10 @@ type ChatMemberUpdated struct {
 	Date int `json:"date"`
 
 	// Previous information about the chat member
-	OldChatMember interface{} `json:"old_chat_member"`
+	OldChatMember ChatMember `json:"old_chat_member"`
 
 	// New information about the chat member
-	NewChatMember interface{} `json:"new_chat_member"`
+	NewChatMember ChatMember `json:"new_chat_member"`
 
 	// Optional. Chat invite link, which was used by the user to join the chat;
 	// for joining by invite link events only.
@@ -1534,7 +1539,7 @@ type ChatJoinRequest struct {
 	Bio string `json:"bio"`
 
 	// Optional. Chat invite link that was used by the user to send the join request
-	InviteLink *ChatInviteLink `json:"invite_link`
+	InviteLink *ChatInviteLink `json:"invite_link"`
 }
 
 type ChatPermissions struct {
@@ -1615,48 +1620,27 @@ type BotCommand struct {
 	Description string `json:"description"`
 }
 
-type BotCommandScopeDefault struct {
-	// Scope type, must be default
-	Type string `json:"type"`
-}
+type BotCommandScopeDefault struct{}
 
-type BotCommandScopeAllPrivateChats struct {
-	// Scope type, must be all_private_chats
-	Type string `json:"type"`
-}
+type BotCommandScopeAllPrivateChats struct{}
 
-type BotCommandScopeAllGroupChats struct {
-	// Scope type, must be all_group_chats
-	Type string `json:"type"`
-}
+type BotCommandScopeAllGroupChats struct{}
 
-type BotCommandScopeAllChatAdministrators struct {
-	// Scope type, must be all_chat_administrators
-	Type string `json:"type"`
-}
+type BotCommandScopeAllChatAdministrators struct{}
 
 type BotCommandScopeChat struct {
-	// Scope type, must be chat
-	Type string `json:"type"`
-
 	// Unique identifier for the target chat or username of the target supergroup
 	// (in the format @supergroupusername)
 	ChatId interface{} `json:"chat_id"`
 }
 
 type BotCommandScopeChatAdministrators struct {
-	// Scope type, must be chat
-	Type string `json:"type"`
-
 	// Unique identifier for the target chat or username of the target supergroup
 	// (in the format @supergroupusername)
 	ChatId interface{} `json:"chat_id"`
 }
 
 type BotCommandScopeChatMember struct {
-	// Scope type, must be chat_member
-	Type string `json:"type"`
-
 	// Unique identifier for the target chat or username of the target supergroup
 	// (in the format @supergroupusername)
 	ChatId interface{} `json:"chat_id"`
@@ -1680,15 +1664,9 @@ type BotShortDescription struct {
 	ShortDescription string `json:"short_description"`
 }
 
-type MenuButtonCommands struct {
-	// Type of the button, must be commands
-	Type string `json:"type"`
-}
+type MenuButtonCommands struct{}
 
 type MenuButtonWebApp struct {
-	// Type of the button, must be web_app
-	Type string `json:"type"`
-
 	// Text on the button
 	Text string `json:"text"`
 
@@ -1698,10 +1676,7 @@ type MenuButtonWebApp struct {
 	WebApp *WebAppInfo `json:"web_app"`
 }
 
-type MenuButtonDefault struct {
-	// Type of the button, must be default
-	Type string `json:"type"`
-}
+type MenuButtonDefault struct{}
 
 type ResponseParameters struct {
 	// Optional. The group has been migrated to a supergroup with the specified identifier.
@@ -1717,39 +1692,33 @@ type ResponseParameters struct {
 }
 
 type InputMediaPhoto struct {
-	// Type of the result, must be video
-	Type string `json:"type"`
-
 	// File to send. Pass a file_id to send a file that exists on the Telegram servers
 	// (recommended), pass an HTTP URL for Telegram to get a file from the Internet,
 	// or pass “attach://<file_attach_name>”
 	// to upload a new one using multipart/form-data under <file_attach_name> name
-	Media string `json:"media"`
+	Media *InputFile `json:"media"`
 
 	// Optional. Caption of the photo to be sent, 0-1024 characters after entities parsing
-	Caption string `json:"caption"`
+	Caption string `json:"caption,omitempty"`
 
 	// Optional. Mode for parsing entities in the photo caption.
 	// See formatting options for more details.
-	ParseMode string `json:"parse_mode"`
+	ParseMode string `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption,
 	// which can be specified instead of parse_mode
-	CaptionEntities []MessageEntity `json:"caption_entities"`
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
 
 	// Optional. Pass True if the photo needs to be covered with a spoiler animation
-	HasSpoiler bool `json:"has_spoiler"`
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
 }
 
 type InputMediaVideo struct {
-	// Type of the result, must be video
-	Type string `json:"type"`
-
 	// File to send. Pass a file_id to send a file that exists on the Telegram servers
 	// (recommended), pass an HTTP URL for Telegram to get a file from the Internet,
 	// or pass “attach://<file_attach_name>” to upload a new one using multipart/form-data under
 	// <file_attach_name> name
-	Media string `json:"media"`
+	Media *InputFile `json:"media"`
 
 	// Optional. Thumbnail of the file sent; can be ignored if thumbnail generation
 	// for the file is supported server-side. The thumbnail should be in JPEG format
@@ -1758,42 +1727,39 @@ type InputMediaVideo struct {
 	// Thumbnails can't be reused and can be only uploaded as a new file, so you can pass
 	// “attach://<file_attach_name>”
 	// if the thumbnail was uploaded using multipart/form-data under <file_attach_name>
-	Thumbnail interface{} `json:"thumbnail"`
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
 
 	// Optional. Caption of the video to be sent, 0-1024 characters after entities parsing
-	Caption string `json:"caption"`
+	Caption string `json:"caption,omitempty"`
 
 	// Optional. Mode for parsing entities in the video caption.
 	// See formatting options for more details.
-	ParseMode string `json:"parse_mode"`
+	ParseMode string `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption,
 	// which can be specified instead of parse_mode
-	CaptionEntities []MessageEntity `json:"caption_entities"`
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
 
 	// Optional. Animation width
-	Width int `json:"width"`
+	Width int `json:"width,omitempty"`
 
 	// Optional. Animation height
-	Height int `json:"height"`
+	Height int `json:"height,omitempty"`
 
 	// Optional. Animation duration in seconds
-	Duration int `json:"duration"`
+	Duration int `json:"duration,omitempty"`
 
 	// Optional. Pass True if the animation needs
 	// to be covered with a spoiler animation
-	HasSpoiler bool `json:"has_spoiler"`
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
 }
 
 type InputMediaAnimation struct {
-	// Type of the result, must be animation
-	Type string `json:"type"`
-
 	// File to send. Pass a file_id to send a file that exists on the Telegram servers
 	// (recommended), pass an HTTP URL for Telegram to get a file from the Internet,
 	// or pass “attach://<file_attach_name>” to upload a new one using multipart/form-data under
 	// <file_attach_name> name
-	Media string `json:"media"`
+	Media *InputFile `json:"media"`
 
 	// Optional. Thumbnail of the file sent; can be ignored if thumbnail generation
 	// for the file is supported server-side. The thumbnail should be in JPEG format
@@ -1802,41 +1768,38 @@ type InputMediaAnimation struct {
 	// Thumbnails can't be reused and can be only uploaded as a new file,
 	// so you can pass “attach://<file_attach_name>” if the thumbnail was uploaded using
 	// multipart/form-data under <file_attach_name>
-	Thumbnail interface{} `json:"thumbnail"`
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
 
 	// Optional. Caption of the animation to be sent, 0-1024 characters after entities parsing
-	Caption string `json:"caption"`
+	Caption string `json:"caption,omitempty"`
 
 	// Optional. Mode for parsing entities in the animation caption.
 	// See formatting options for more details.
-	ParseMode string `json:"parse_mode"`
+	ParseMode string `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption,
 	// which can be specified instead of parse_mode
-	CaptionEntities []MessageEntity `json:"caption_entities"`
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
 
 	// Optional. Animation width
-	Width int `json:"width"`
+	Width int `json:"width,omitempty"`
 
 	// Optional. Animation height
-	Height int `json:"height"`
+	Height int `json:"height,omitempty"`
 
 	// Optional. Animation duration in seconds
-	Duration int `json"duration"`
+	Duration int `json:"duration,omitempty"`
 
 	// Optional. Pass True if the animation needs to be covered with a spoiler animation
-	HasSpoiler bool `json:"has_spoiler"`
+	HasSpoiler bool `json:"has_spoiler,omitempty"`
 }
 
 type InputMediaAudio struct {
-	// Type of the result, must be audio
-	Type string `json:"type"`
-
 	// File to send. Pass a file_id to send a file that exists on the Telegram servers
 	// (recommended), pass an HTTP URL for Telegram to get a file from the Internet,
 	// or pass “attach://<file_attach_name>” to upload a new one using multipart/form-data
 	// under <file_attach_name> name
-	Media string `json:"media"`
+	Media *InputFile `json:"media"`
 
 	// Optional. Thumbnail of the file sent; can be ignored if thumbnail generation
 	// for the file is supported server-side. The thumbnail should be in JPEG format
@@ -1845,38 +1808,35 @@ type InputMediaAudio struct {
 	// Thumbnails can't be reused and can be only uploaded as a new file, so you can pass
 	// “attach://<file_attach_name>”
 	// if the thumbnail was uploaded using multipart/form-data under <file_attach_name>
-	Thumbnail interface{} `json:"thumbnail"`
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
 
 	// Optional. Caption of the audio to be sent, 0-1024 characters after entities parsing
-	Caption string `json:"caption"`
+	Caption string `json:"caption,omitempty"`
 
 	// Optional. Mode for parsing entities in the audio caption.
 	// See formatting options for more details.
-	ParseMode string `json:"parse_mode"`
+	ParseMode string `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption,
 	// which can be specified instead of parse_mode
-	CaptionEntities []MessageEntity `json:"caption_entities"`
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
 
 	// Optional. Duration of the audio in seconds
-	Duration int `json"duration"`
+	Duration int `json:"duration,omitempty"`
 
 	// Optional. Performer of the audio
-	Performer string `json:"performer"`
+	Performer string `json:"performer,omitempty"`
 
 	// Optional. Title of the audio
-	Title string `json:"title"`
+	Title string `json:"title,omitempty"`
 }
 
 type InputMediaDocument struct {
-	// Type of the result, must be document
-	Type string `json:"type"`
-
 	// File to send. Pass a file_id to send a file that exists on the Telegram servers
 	// (recommended), pass an HTTP URL for Telegram to get a file from the Internet,
 	// or pass “attach://<file_attach_name>”
 	// to upload a new one using multipart/form-data under <file_attach_name> name
-	Media string `json:"media"`
+	Media *InputFile `json:"media"`
 
 	// Optional. Thumbnail of the file sent; can be ignored if thumbnail generation
 	// for the file is supported server-side. The thumbnail should be in JPEG format
@@ -1885,144 +1845,21 @@ type InputMediaDocument struct {
 	// Thumbnails can't be reused and can be only uploaded as a new file,
 	// so you can pass “attach://<file_attach_name>”
 	// if the thumbnail was uploaded using multipart/form-data under <file_attach_name>
-	Thumbnail interface{} `json:"thumbnail"`
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
 
 	// Optional. Caption of the document to be sent, 0-1024 characters after entities parsing
-	Caption string `json:"caption"`
+	Caption string `json:"caption,omitempty"`
 
 	// Optional. Mode for parsing entities in the document caption.
 	// See formatting options for more details.
-	ParseMode string `json:"parse_mode"`
+	ParseMode string `json:"parse_mode,omitempty"`
 
 	// Optional. List of special entities that appear in the caption,
 	// which can be specified instead of parse_mode
-	CaptionEntities []MessageEntity `json:"caption_entities"`
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
 
 	// Optional. Disables automatic server-side content type detection
 	// for files uploaded using multipart/form-data.
 	// Always True, if the document is sent as part of an album.
-	DisableContentTypeDetection bool `json:"disable_content_type_detection"`
-}
-
-// type SetMyCommands setMyName {
-// 	// A JSON-serialized list of bot commands to be set as the list of the bot's commands.
-// 	// At most 100 commands can be specified.
-// 	Commands [][]BotCommand `json:"commands"`
-
-// 	// A JSON-serialized object, describing scope of users
-// 	// for which the commands are relevant.
-// 	// Defaults to BotCommandScopeDefault.
-// 	Scope *BotCommandScope `json:"scope"`
-
-// 	// A two-letter ISO 639-1 language code. If empty,
-// 	// commands will be applied to all users from the given scope,
-// 	// for whose language there are no dedicated commands
-// 	LanguageCode string `json:"language_code"`
-// }
-
-// type DeleteMyCommands struct {
-// 	// A JSON-serialized object, describing
-// 	// scope of users for which the commands are relevant.
-// 	// Defaults to BotCommandScopeDefault.
-// 	Scope *BotCommandScope `json:"scope"`
-
-// 	// A two-letter ISO 639-1 language code.
-// 	// If empty, commands will be applied to all users from the given scope,
-// 	// for whose language there are no dedicated commands
-// 	LanguageCode string `json:"language_code"`
-// }
-
-// type GetMyCommands struct {
-// 	// A JSON-serialized object, describing scope of users.
-// 	// Defaults to BotCommandScopeDefault.
-// 	Scope *BotCommandScope `json:"scope"`
-
-// 	// A two-letter ISO 639-1 language code or an empty string
-// 	LanguageCode string `json:"language_code"`
-// }
-
-// type setMyName struct {
-// 	// New bot name; 0-64 characters. Pass an empty string to remove
-// 	// the dedicated name for the given language.
-// 	Name string `json:"name"`
-
-// 	// A two-letter ISO 639-1 language code.
-// 	// If empty, the name will be shown to all users for whose language there is no dedicated name.
-// 	LanguageCode string `json:"language_code"`
-// }
-
-// type GetMyName struct {
-// 	LanguageCode string `json:"language_code"`
-// }
-
-// type SetMyDescription struct {
-// 	// New bot description; 0-512 characters.
-// 	// Pass an empty string to remove the dedicated description for the given language.
-// 	Description string `json:"description"`
-
-// 	// A two-letter ISO 639-1 language code. If empty, the description
-// 	// will be applied to all users for whose language there is no dedicated description.
-// 	LanguageCode string `json:"language_code"`
-// }
-
-// type GetMyDescription struct {
-// 	// A two-letter ISO 639-1 language code or an empty string
-// 	LanguageCode string `json:"language_code"`
-// }
-
-// type SetMyShortDescription struct {
-// 	// New short description for the bot; 0-120 characters.
-// 	// Pass an empty string to remove the dedicated short description for the given language.
-// 	ShortDescription int `json:"short_description"`
-
-// 	// A two-letter ISO 639-1 language code. If empty, the short description
-// 	// will be applied to all users for whose language there is no dedicated short description.
-// 	LanguageCode string `json:"language_code"`
-// }
-
-// type GetMyShortDescription struct {
-// 	// A two-letter ISO 639-1 language code or an empty string
-// 	LanguageCode string `json:"language_code"`
-// }
-
-// type SetChatMenuButton struct {
-// 	// Unique identifier for the target private chat.
-// 	// If not specified, default bot's menu button will be changed
-// 	ChatId int `json:"chat_id"`
-
-// 	// A JSON-serialized object for the bot's new menu button.
-// 	// Defaults to MenuButtonDefault
-// 	MenuButton *MenuButton `json:"menu_button"`
-// }
-
-// type SetChatMenuButton struct {
-// 	// Unique identifier for the target private chat.
-// 	// If not specified, default bot's menu button will be changed
-// 	ChatId int `json:"chat_id"`
-
-// 	// A JSON-serialized object for the bot's new menu button.
-// 	// Defaults to MenuButtonDefault
-// 	MenuButton *MenuButton `json:"menu_button"`
-// }
-
-// type GetChatMenuButton struct {
-// 	// Unique identifier for the target private chat.
-// 	// If not specified, default bot's menu button will be returned
-// 	ChatId int `json:"chat_id"`
-// }
-
-// type SetMyDefaultAdministratorRights struct {
-// 	// A JSON-serialized object describing new default administrator rights.
-// 	// If not specified, the default administrator rights will be cleared.
-// 	Rights *ChatAdministratorRights `json:"rights"`
-
-// 	// Pass True to change the default administrator rights of the bot in channels. Otherwise,
-// 	// the default administrator rights of the bot for groups and supergroups will be changed.
-// 	ForChannels bool `json:"for_channels"`
-// }
-
-// type GetMyDefaultAdministratorRights struct {
-// 	// Pass True to get default administrator rights of the bot in channels.
-// 	// Otherwise, default administrator rights of the bot for groups and supergroups will be returned.
-// 	ForChannels bool `json:"for_channels"`
-// }
+	DisableContentTypeDetection bool `json:"disable_content_type_detection,omitempty"`
+}
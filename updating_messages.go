@@ -0,0 +1,85 @@
+package gogram
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Use this method to edit text and game messages. On success, if the edited message is not an inline message,
+// the edited Message is returned, otherwise nil is returned.
+func (bot *Bot) EditMessageText(ctx context.Context, params *EditMessageTextParams) (*Message, error) {
+	return bot.editMessage(ctx, "editMessageText", params)
+}
+
+// Use this method to edit captions of messages. On success, if the edited message is not an inline message,
+// the edited Message is returned, otherwise nil is returned.
+func (bot *Bot) EditMessageCaption(ctx context.Context, params *EditMessageCaptionParams) (*Message, error) {
+	return bot.editMessage(ctx, "editMessageCaption", params)
+}
+
+// Use this method to edit animation, audio, document, photo, or video messages. If a message is part of a message album,
+// then it can be edited only to an audio for audio albums, only to a document for document albums
+// and to a photo or a video otherwise. When an inline message is edited, a new file can't be uploaded;
+// use a previously uploaded file via its file_id or specify a URL. On success, if the edited message
+// is not an inline message, the edited Message is returned, otherwise nil is returned.
+func (bot *Bot) EditMessageMedia(ctx context.Context, params *EditMessageMediaParams) (*Message, error) {
+	return bot.editMessage(ctx, "editMessageMedia", params)
+}
+
+// Use this method to edit live location messages. A location can be edited until its live_period expires
+// or editing is explicitly disabled by a call to stopMessageLiveLocation. On success, if the edited message
+// is not an inline message, the edited Message is returned, otherwise nil is returned.
+func (bot *Bot) EditMessageLiveLocation(ctx context.Context, params *EditMessageLiveLocationParams) (*Message, error) {
+	return bot.editMessage(ctx, "editMessageLiveLocation", params)
+}
+
+// Use this method to stop updating a live location message before live_period expires. On success,
+// if the message is not an inline message, the edited Message is returned, otherwise nil is returned.
+func (bot *Bot) StopMessageLiveLocation(ctx context.Context, params *StopMessageLiveLocationParams) (*Message, error) {
+	return bot.editMessage(ctx, "stopMessageLiveLocation", params)
+}
+
+// Use this method to edit only the reply markup of messages. On success, if the edited message
+// is not an inline message, the edited Message is returned, otherwise nil is returned.
+func (bot *Bot) EditMessageReplyMarkup(ctx context.Context, params *EditMessageReplyMarkupParams) (*Message, error) {
+	return bot.editMessage(ctx, "editMessageReplyMarkup", params)
+}
+
+// Use this method to stop a poll which was sent by the bot. On success, the stopped Poll is returned.
+func (bot *Bot) StopPoll(ctx context.Context, params *StopPollParams) (*Poll, error) {
+	return Call[*Poll](ctx, bot, "stopPoll", params)
+}
+
+// Use this method to delete a message, including service messages, with the following limitations:
+//   - A message can only be deleted if it was sent less than 48 hours ago.
+//   - Service messages about a supergroup, channel, or forum topic creation can't be deleted.
+//   - A dice message in a private chat can only be deleted if it was sent more than 24 hours ago.
+//   - Bots can delete outgoing messages in private chats, groups, and supergroups.
+//   - Bots can delete incoming messages in private chats.
+//   - Bots granted can_post_messages permissions can delete outgoing messages in channels.
+//   - If the bot is an administrator of a group, it can delete any message there.
+//   - If the bot has can_delete_messages permission in a supergroup or a channel, it can delete any message there.
+//
+// Returns nil on success.
+func (bot *Bot) DeleteMessage(ctx context.Context, params *DeleteMessageParams) (err error) {
+	_, err = Call[bool](ctx, bot, "deleteMessage", params)
+	return
+}
+
+// Makes a request to a method which returns the edited Message for messages in chats
+// and True for inline messages, in which case nil is returned
+func (bot *Bot) editMessage(ctx context.Context, method string, params any) (*Message, error) {
+	result, err := Call[json.RawMessage](ctx, bot, method, params)
+	if err != nil {
+		return nil, err
+	}
+	if string(result) == "true" {
+		return nil, nil
+	}
+	var message Message
+	if err := json.Unmarshal(result, &message); err != nil {
+		return nil, fmt.Errorf("method %s: decoding result: %w", method, err)
+	}
+	return &message, nil
+}
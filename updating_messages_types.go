@@ -0,0 +1,128 @@
+package gogram
+
+// MessageTarget identifies the message to update: either a message in a chat, given by ChatId and MessageId,
+// or a message sent via the bot in inline mode, given by InlineMessageId. Use ChatMessage or InlineMessage to create one.
+type MessageTarget struct {
+	// Optional. Required if InlineMessageId is not specified. Unique identifier for the target chat
+	// or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id,omitempty"`
+
+	// Optional. Required if InlineMessageId is not specified. Identifier of the message to edit
+	MessageId int `json:"message_id,omitempty"`
+
+	// Optional. Required if ChatId and MessageId are not specified. Identifier of the inline message
+	InlineMessageId string `json:"inline_message_id,omitempty"`
+}
+
+// Returns MessageTarget of the message with messageId in the chat with chatId
+func ChatMessage(chatId interface{}, messageId int) MessageTarget {
+	return MessageTarget{ChatId: chatId, MessageId: messageId}
+}
+
+// Returns MessageTarget of the message sent via the bot in inline mode
+func InlineMessage(inlineMessageId string) MessageTarget {
+	return MessageTarget{InlineMessageId: inlineMessageId}
+}
+
+type EditMessageTextParams struct {
+	MessageTarget
+
+	// New text of the message, 1-4096 characters after entities parsing
+	Text string `json:"text"`
+
+	// Optional. Mode for parsing entities in the message text. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+
+	// Optional. A JSON-serialized list of special entities that appear in message text,
+	// which can be specified instead of parse_mode
+	Entities []MessageEntity `json:"entities,omitempty"`
+
+	// Optional. Disables link previews for links in this message
+	DisableWebPagePreview bool `json:"disable_web_page_preview,omitempty"`
+
+	// Optional. A JSON-serialized object for an inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+type EditMessageCaptionParams struct {
+	MessageTarget
+
+	// Optional. New caption of the message, 0-1024 characters after entities parsing
+	Caption string `json:"caption,omitempty"`
+
+	// Optional. Mode for parsing entities in the message caption. See formatting options for more details.
+	ParseMode string `json:"parse_mode,omitempty"`
+
+	// Optional. A JSON-serialized list of special entities that appear in the caption,
+	// which can be specified instead of parse_mode
+	CaptionEntities []MessageEntity `json:"caption_entities,omitempty"`
+
+	// Optional. A JSON-serialized object for an inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+type EditMessageMediaParams struct {
+	MessageTarget
+
+	// A JSON-serialized object for a new media content of the message
+	Media InputMedia `json:"media"`
+
+	// Optional. A JSON-serialized object for a new inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+type EditMessageLiveLocationParams struct {
+	MessageTarget
+
+	// Latitude of new location
+	Latitude float64 `json:"latitude"`
+
+	// Longitude of new location
+	Longitude float64 `json:"longitude"`
+
+	// Optional. The radius of uncertainty for the location, measured in meters; 0-1500
+	HorizontalAccuracy float64 `json:"horizontal_accuracy,omitempty"`
+
+	// Optional. Direction in which the user is moving, in degrees. Must be between 1 and 360 if specified.
+	Heading int `json:"heading,omitempty"`
+
+	// Optional. The maximum distance for proximity alerts about approaching another chat member, in meters.
+	// Must be between 1 and 100000 if specified.
+	ProximityAlertRadius int `json:"proximity_alert_radius,omitempty"`
+
+	// Optional. A JSON-serialized object for a new inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+type StopMessageLiveLocationParams struct {
+	MessageTarget
+
+	// Optional. A JSON-serialized object for a new inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+type EditMessageReplyMarkupParams struct {
+	MessageTarget
+
+	// Optional. A JSON-serialized object for an inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+type StopPollParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Identifier of the original message with the poll
+	MessageId int `json:"message_id"`
+
+	// Optional. A JSON-serialized object for a new message inline keyboard.
+	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
+}
+
+type DeleteMessageParams struct {
+	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
+	ChatId interface{} `json:"chat_id"`
+
+	// Identifier of the message to delete
+	MessageId int `json:"message_id"`
+}
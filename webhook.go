@@ -0,0 +1,68 @@
+package gogram
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+)
+
+// Header in which Telegram sends the secret_token passed to setWebhook
+const secretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+
+// Maximum size of a webhook request body accepted by WebhookHandler
+const maxWebhookBodySize = 1 << 20
+
+// WebhookHandler is an http.Handler that receives updates sent by Telegram to a webhook
+// set with SetWebhook and delivers them on the channel returned by Updates,
+// the same way StartPolling does.
+type WebhookHandler struct {
+	// Secret token passed to setWebhook. Requests without a matching
+	// X-Telegram-Bot-Api-Secret-Token header are rejected. Empty disables the check.
+	SecretToken string
+
+	updates chan Update
+}
+
+// Creates new webhook handler which accepts only requests carrying secretToken
+func NewWebhookHandler(secretToken string) *WebhookHandler {
+	return &WebhookHandler{
+		SecretToken: secretToken,
+		updates:     make(chan Update),
+	}
+}
+
+// Updates returns the channel on which received updates are delivered
+func (handler *WebhookHandler) Updates() <-chan Update {
+	return handler.updates
+}
+
+// ServeHTTP decodes the update from the request body and waits until it is received from
+// the Updates channel. If the request is cancelled first, the update is not acknowledged,
+// so Telegram delivers it again later.
+func (handler *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if handler.SecretToken != "" {
+		token := r.Header.Get(secretTokenHeader)
+		if subtle.ConstantTimeCompare([]byte(token), []byte(handler.SecretToken)) != 1 {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+	}
+
+	var update Update
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxWebhookBodySize)).Decode(&update); err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	select {
+	case handler.updates <- update:
+		w.WriteHeader(http.StatusOK)
+	case <-r.Context().Done():
+		http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+	}
+}
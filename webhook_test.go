@@ -0,0 +1,80 @@
+package gogram
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSecretToken = "secret"
+
+// Returns a webhook request with body carrying token, unless it is empty
+func webhookRequest(method, body, token string) *http.Request {
+	request := httptest.NewRequest(method, "/webhook", strings.NewReader(body))
+	if token != "" {
+		request.Header.Set(secretTokenHeader, token)
+	}
+	return request
+}
+
+func TestWebhookHandlerRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *http.Request
+		code    int
+	}{
+		{"missing token", webhookRequest(http.MethodPost, `{"update_id":1}`, ""), http.StatusForbidden},
+		{"wrong token", webhookRequest(http.MethodPost, `{"update_id":1}`, "other"), http.StatusForbidden},
+		{"not POST", webhookRequest(http.MethodGet, "", testSecretToken), http.StatusMethodNotAllowed},
+		{"bad body", webhookRequest(http.MethodPost, `{"update_id":`, testSecretToken), http.StatusBadRequest},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := NewWebhookHandler(testSecretToken)
+			recorder := httptest.NewRecorder()
+			// Nothing receives from Updates, so a delivered update would block the handler
+			handler.ServeHTTP(recorder, test.request)
+			if recorder.Code != test.code {
+				t.Errorf("status %d, want %d", recorder.Code, test.code)
+			}
+		})
+	}
+}
+
+func TestWebhookHandlerDelivers(t *testing.T) {
+	handler := NewWebhookHandler(testSecretToken)
+	recorder := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		handler.ServeHTTP(recorder, webhookRequest(http.MethodPost, `{"update_id":7,"message":{"message_id":1,"text":"hi"}}`, testSecretToken))
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("request answered before the update was received")
+	case <-time.After(20 * time.Millisecond):
+	}
+	update := receiveUpdate(t, handler.Updates())
+	if update.UpdateId != 7 || update.Message == nil || update.Message.Text != "hi" {
+		t.Errorf("received %+v, want update 7", update)
+	}
+	<-done
+	if recorder.Code != http.StatusOK {
+		t.Errorf("status %d, want %d", recorder.Code, http.StatusOK)
+	}
+}
+
+func TestWebhookHandlerCancelled(t *testing.T) {
+	handler := NewWebhookHandler("")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, webhookRequest(http.MethodPost, `{"update_id":1}`, "").WithContext(ctx))
+	if recorder.Code != http.StatusServiceUnavailable {
+		t.Errorf("status %d, want %d", recorder.Code, http.StatusServiceUnavailable)
+	}
+}